@@ -3,6 +3,7 @@ module github.com/stjudewashere/seonaut
 go 1.23.0
 
 require (
+	github.com/andybalholm/brotli v1.2.0
 	github.com/antchfx/htmlquery v1.3.4
 	github.com/go-sql-driver/mysql v1.9.3
 	github.com/golang-migrate/migrate/v4 v4.18.3
@@ -10,6 +11,7 @@ require (
 	github.com/gorilla/securecookie v1.1.2
 	github.com/gorilla/sessions v1.4.0
 	github.com/gorilla/websocket v1.5.3
+	github.com/klauspost/compress v1.18.0
 	github.com/microcosm-cc/bluemonday v1.0.27
 	github.com/oxffaa/gopher-parse-sitemap v0.0.0-20191021113419-005d2eb1def4
 	github.com/slyrz/warc v0.0.0-20150806225202-a50edd19b690
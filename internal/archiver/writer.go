@@ -153,6 +153,8 @@ func (s *Writer) readResponseBody(bodyCopy *bytes.Buffer, response *http.Respons
 }
 
 // readResponseHeaders reads the response's headers into a bytes.Buffer.
+// The crawler decodes the response body, so the Content-Encoding and Content-Length
+// headers are left out as they don't match the archived body.
 func (s *Writer) readResponseHeaders(contentBuffer *bytes.Buffer, response *http.Response) {
 	contentBuffer.WriteString(
 		fmt.Sprintf(
@@ -165,6 +167,10 @@ func (s *Writer) readResponseHeaders(contentBuffer *bytes.Buffer, response *http
 	)
 
 	for key, values := range response.Header {
+		if key == "Content-Encoding" || key == "Content-Length" {
+			continue
+		}
+
 		for _, value := range values {
 			contentBuffer.WriteString(fmt.Sprintf("%s: %s\r\n", key, value))
 		}
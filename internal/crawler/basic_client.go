@@ -1,11 +1,30 @@
 package crawler
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"log"
 	"math"
 	"net/http"
 	"net/http/httptrace"
 	"net/url"
+	"strings"
 	"time"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// acceptEncoding contains the compression formats the client can decode.
+	acceptEncoding = "gzip, deflate, br, zstd"
+
+	// maxDecodedSize is the limit of the decoded response body in bytes, so highly compressed
+	// responses can't exhaust the memory. Bodies that exceed it are truncated.
+	maxDecodedSize = 10 * 1024 * 1024
 )
 
 type HTTPRequester interface {
@@ -73,6 +92,8 @@ func (c *BasicClient) Head(urlStr string) (*ClientResponse, error) {
 
 // do executes a request and returns its response and error.
 // It sets the client's User-Agent as well as the BasicAuth details if they are available.
+// The client advertises gzip and deflate compression, so the response body is read and
+// decoded here, keeping the number of bytes transferred in the ClientResponse.
 func (c *BasicClient) do(req *http.Request) (*ClientResponse, error) {
 	cr := &ClientResponse{}
 
@@ -85,6 +106,7 @@ func (c *BasicClient) do(req *http.Request) (*ClientResponse, error) {
 	}
 
 	req.Header.Set("User-Agent", c.Options.UserAgent)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
 	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
 
 	resp, err := c.client.Do(req)
@@ -92,11 +114,69 @@ func (c *BasicClient) do(req *http.Request) (*ClientResponse, error) {
 		return nil, err
 	}
 
+	if resp.Body != nil {
+		raw, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		cr.TransferSize = int64(len(raw))
+		resp.Body = io.NopCloser(bytes.NewReader(decodeBody(raw, resp.Header.Get("Content-Encoding"))))
+	}
+
 	cr.Response = resp
 
 	return cr, nil
 }
 
+// decodeBody returns the body decoded according to the Content-Encoding header. If the body
+// can't be decoded it is returned as it is.
+func decodeBody(body []byte, encoding string) []byte {
+	var r io.ReadCloser
+	var err error
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "gzip", "x-gzip":
+		r, err = gzip.NewReader(bytes.NewReader(body))
+	case "deflate":
+		// Some servers send raw deflate data instead of the zlib format.
+		r, err = zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			r, err = flate.NewReader(bytes.NewReader(body)), nil
+		}
+	case "br":
+		r = io.NopCloser(brotli.NewReader(bytes.NewReader(body)))
+	case "zstd":
+		var d *zstd.Decoder
+		d, err = zstd.NewReader(bytes.NewReader(body), zstd.WithDecoderConcurrency(1))
+		if err == nil {
+			r = d.IOReadCloser()
+		}
+	default:
+		return body
+	}
+
+	if err != nil {
+		log.Printf("decodeBody %s: %v\n", encoding, err)
+		return body
+	}
+	defer r.Close()
+
+	decoded, err := io.ReadAll(io.LimitReader(r, maxDecodedSize+1))
+	if err != nil {
+		log.Printf("decodeBody %s: %v\n", encoding, err)
+		return body
+	}
+
+	if len(decoded) > maxDecodedSize {
+		log.Printf("decodeBody %s: decoded body exceeds %d bytes\n", encoding, maxDecodedSize)
+		decoded = decoded[:maxDecodedSize]
+	}
+
+	return decoded
+}
+
 // GetUA returns the user-agent set for this client.
 func (c *BasicClient) GetUA() string {
 	return c.Options.UserAgent
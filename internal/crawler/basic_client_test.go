@@ -1,17 +1,23 @@
 package crawler_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stjudewashere/seonaut/internal/crawler"
 )
 
 type mockClient struct {
 	lastRequest *http.Request
 	ForceError  bool
+	Response    *http.Response
 }
 
 func (m *mockClient) Do(req *http.Request) (*http.Response, error) {
@@ -21,6 +27,10 @@ func (m *mockClient) Do(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("mock error")
 	}
 
+	if m.Response != nil {
+		return m.Response, nil
+	}
+
 	return &http.Response{
 		StatusCode: http.StatusOK,
 	}, nil
@@ -145,3 +155,131 @@ func TestHTTPError(t *testing.T) {
 		t.Fatal("expected an error, got none")
 	}
 }
+
+// Test compressed responses are decoded and the transfer size is recorded.
+func TestCompressedResponse(t *testing.T) {
+	body := bytes.Repeat([]byte("<p>compressed body</p>"), 100)
+
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	w.Write(body)
+	w.Close()
+
+	header := http.Header{}
+	header.Set("Content-Encoding", "gzip")
+
+	mockClient := &mockClient{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader(compressed.Bytes())),
+		},
+	}
+	client := crawler.NewBasicClient(&crawler.ClientOptions{}, mockClient)
+
+	r, err := client.Get("http://example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if mockClient.lastRequest.Header.Get("Accept-Encoding") == "" {
+		t.Errorf("expected Accept-Encoding header to be set")
+	}
+
+	if r.TransferSize != int64(compressed.Len()) {
+		t.Errorf("expected TransferSize to be %d, got %d", compressed.Len(), r.TransferSize)
+	}
+
+	decoded, err := io.ReadAll(r.Response.Body)
+	if err != nil {
+		t.Fatalf("expected no error reading the body, got %v", err)
+	}
+
+	if !bytes.Equal(decoded, body) {
+		t.Errorf("expected the decoded body to match the original body")
+	}
+}
+
+// Test brotli and zstd compressed responses are decoded.
+func TestBrotliZstdResponse(t *testing.T) {
+	body := bytes.Repeat([]byte("<p>compressed body</p>"), 100)
+
+	var br bytes.Buffer
+	bw := brotli.NewWriter(&br)
+	bw.Write(body)
+	bw.Close()
+
+	var zs bytes.Buffer
+	zw, _ := zstd.NewWriter(&zs)
+	zw.Write(body)
+	zw.Close()
+
+	table := map[string][]byte{
+		"br":   br.Bytes(),
+		"zstd": zs.Bytes(),
+	}
+
+	for encoding, compressed := range table {
+		header := http.Header{}
+		header.Set("Content-Encoding", encoding)
+
+		mockClient := &mockClient{
+			Response: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(bytes.NewReader(compressed)),
+			},
+		}
+		client := crawler.NewBasicClient(&crawler.ClientOptions{}, mockClient)
+
+		r, err := client.Get("http://example.com")
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", encoding, err)
+		}
+
+		decoded, err := io.ReadAll(r.Response.Body)
+		if err != nil {
+			t.Fatalf("%s: expected no error reading the body, got %v", encoding, err)
+		}
+
+		if !bytes.Equal(decoded, body) {
+			t.Errorf("%s: expected the decoded body to match the original body", encoding)
+		}
+	}
+}
+
+// Test highly compressed responses are truncated when decoded.
+func TestCompressedResponseLimit(t *testing.T) {
+	maxSize := 10 * 1024 * 1024
+
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	w.Write(make([]byte, maxSize*2))
+	w.Close()
+
+	header := http.Header{}
+	header.Set("Content-Encoding", "gzip")
+
+	mockClient := &mockClient{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader(compressed.Bytes())),
+		},
+	}
+	client := crawler.NewBasicClient(&crawler.ClientOptions{}, mockClient)
+
+	r, err := client.Get("http://example.com")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	decoded, err := io.ReadAll(r.Response.Body)
+	if err != nil {
+		t.Fatalf("expected no error reading the body, got %v", err)
+	}
+
+	if len(decoded) != maxSize {
+		t.Errorf("expected the decoded body to be truncated to %d bytes, got %d", maxSize, len(decoded))
+	}
+}
@@ -67,6 +67,7 @@ type Crawler struct {
 	sitemapChecker   *SitemapChecker
 	sitemapExists    bool
 	sitemapIsBlocked bool
+	completed        bool
 	sitemaps         []string
 	robotsChecker    *RobotsChecker
 	allowedDomains   map[string]bool
@@ -77,8 +78,9 @@ type Crawler struct {
 }
 
 type ClientResponse struct {
-	Response *http.Response
-	TTFB     int
+	Response     *http.Response
+	TTFB         int
+	TransferSize int64 // Response body size in bytes before decoding its content encoding.
 }
 
 type RequestMessage struct {
@@ -89,14 +91,15 @@ type RequestMessage struct {
 }
 
 type ResponseMessage struct {
-	URL       *url.URL
-	Response  *http.Response
-	Error     error
-	TTFB      int
-	Blocked   bool
-	InSitemap bool
-	Timeout   bool
-	Data      interface{}
+	URL          *url.URL
+	Response     *http.Response
+	Error        error
+	TTFB         int
+	TransferSize int64
+	Blocked      bool
+	InSitemap    bool
+	Timeout      bool
+	Data         interface{}
 }
 
 func NewCrawler(parsedURL *url.URL, options *Options, client Client) *Crawler {
@@ -149,6 +152,7 @@ func (c *Crawler) Start() {
 	}
 
 	if !c.queue.Active() {
+		c.completed = true
 		return
 	}
 
@@ -171,6 +175,7 @@ func (c *Crawler) Start() {
 		}
 
 		if !c.queue.Active() || c.status.Crawled >= c.options.CrawlLimit {
+			c.completed = !c.queue.Active()
 			break
 		}
 	}
@@ -223,6 +228,12 @@ func (c *Crawler) SitemapIsBlocked() bool {
 	return c.sitemapIsBlocked
 }
 
+// Returns true if the crawler crawled all the URLs it found. It is false if the crawler was
+// stopped, timed out or hit the crawl limit before the queue was empty.
+func (c *Crawler) Completed() bool {
+	return c.completed
+}
+
 // Stops the cralwer by canceling the cralwer context.
 func (c *Crawler) Stop() {
 	c.cancel()
@@ -320,6 +331,7 @@ func (c *Crawler) consumer(reqStream <-chan *RequestMessage, respStream chan<- *
 			if rm.Error == nil {
 				rm.Response = r.Response
 				rm.TTFB = r.TTFB
+				rm.TransferSize = r.TransferSize
 			}
 
 			respStream <- rm
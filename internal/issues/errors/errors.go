@@ -88,4 +88,50 @@ const (
 	ErrorDOMSize                                 // HTML documents with excessive DOM size
 	ErrorPaginationLink                          // Pages with next and prev attributes missing the actual link
 	ErrorLocalhostLinks                          // Pages with links to localhost or 127.0.0.1
+	ErrorInvalidStructuredData                   // Pages with invalid JSON-LD structured data
+	ErrorIncompleteStructuredData                // Pages with structured data missing required properties
+	ErrorMissingOpenGraph                        // Pages missing the og:title or og:image tags
+	ErrorRelativeOpenGraphImage                  // Pages with a relative og:image URL
+	ErrorBrokenOpenGraphImage                    // Pages with an og:image URL returning an error
+	ErrorOpenGraphURLMismatch                    // Pages with an og:url that doesn't match the canonical
+	ErrorNearDuplicateContent                    // Pages with text very similar to other pages
+	ErrorEmptyAnchorText                         // Pages with links without anchor text
+	ErrorGenericAnchorText                       // Pages with links with generic anchor texts such as "click here"
+	ErrorImageLinkWithoutAlt                     // Pages with image links where the image has no alt text
+	ErrorActiveMixedContent                      // HTTPS pages loading scripts, stylesheets, iframes or forms over HTTP
+	ErrorPassiveMixedContent                     // HTTPS pages loading images, audios, videos or CSS resources over HTTP
+	ErrorInputWithoutLabel                       // Pages with form inputs without an associated label
+	ErrorButtonWithoutName                       // Pages with buttons without an accessible name
+	ErrorLinkWithoutName                         // Pages with links without an accessible name
+	ErrorInvalidAriaLabelledBy                   // Pages with aria-labelledby referencing missing or duplicated ids
+	ErrorPositiveTabindex                        // Pages with elements with a tabindex greater than 0
+	ErrorIframeWithoutTitle                      // Pages with iframes without a title
+	ErrorTableWithoutHeaders                     // Pages with tables without header cells
+	ErrorUserScalableDisabled                    // Pages with a viewport that disables zooming
+	ErrorSoft404                                 // Pages returning a 200 status code that look like not found pages
+	ErrorStaticCacheControl                      // Scripts, styles, images and fonts without an effective Cache-Control
+	ErrorHTMLNoStore                             // Indexable HTML pages with Cache-Control no-store and no cookies
+	ErrorMissingValidators                       // Responses without ETag and Last-Modified headers
+	ErrorUncompressedText                        // Text responses not compressed with gzip, br, deflate or zstd
+	ErrorCookieWithoutSecure                     // HTTPS responses setting cookies without the Secure attribute
+	ErrorCookieWithoutHttpOnly                   // Responses setting cookies without the HttpOnly attribute
+	ErrorCookieWithoutSameSite                   // Responses setting cookies without a valid SameSite attribute
+	ErrorInvalidCSP                              // Pages with a content security policy that is not valid
+	ErrorUnsafeCSP                               // Pages with a content security policy allowing unsafe-inline or wildcard scripts
+	ErrorReferrerPolicy                          // Pages with a missing, invalid or unsafe Referrer-Policy
+	ErrorMissingPermissionsPolicy                // Pages without a Permissions-Policy header
+	ErrorMissingFramingProtection                // Pages without X-Frame-Options or a CSP frame-ancestors directive
+	ErrorWeakHSTS                                // HTTPS pages with a short HSTS max-age or without includeSubDomains
+	ErrorPageWeightBudget                        // Pages heavier than the page weight budget
+	ErrorScriptsWeightBudget                     // Pages with more scripts weight than the budget
+	ErrorPageRequestsBudget                      // Pages loading more resources than the requests budget
+	ErrorRenderBlockingScript                    // Pages with synchronous scripts in the head element
+	ErrorTooManyHeadStylesheets                  // Pages with too many stylesheets in the head element
+	ErrorUnusedPreload                           // Pages preloading resources that are not used
+	ErrorLazyLCPImage                            // Pages with a lazy loaded main image
+	ErrorImagesWithoutLazyLoading                // Pages with many images without lazy loading
+	ErrorOversizedImage                          // Pages with images much larger than their displayed size
+	ErrorDistortedImage                          // Pages with images displayed with a different aspect ratio
+	ErrorLegacyImageFormat                       // Large JPEG, PNG or GIF images
+	ErrorBrokenSrcset                            // Pages with srcset candidates returning an error status code
 )
@@ -1,26 +1,144 @@
 package multipage
 
 import (
+	"log"
+
 	"github.com/stjudewashere/seonaut/internal/issues/errors"
 	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/simhash"
 )
 
-// Creates a MultipageIssueReporter object that contains the SQL query to check for pages with identical titles.
-// It considers factors such as the HTTP status code, media type and whether they are canonical or not.
+// Creates a MultipageIssueReporter object that contains the SQL query to check for HTML pages with
+// identical main content text, so pages that only differ in their boilerplate are also reported.
 func (sr *SqlReporter) DuplicatedContent(c *models.Crawl) *models.MultipageIssueReporter {
 	query := `
 		SELECT id
 		FROM pagereports
-		WHERE body_hash IN (
-			SELECT body_hash
+		WHERE main_text_hash IN (
+			SELECT main_text_hash
 			FROM pagereports
-			WHERE crawl_id = ? AND media_type = "text/html" AND body_hash <> ""
-			GROUP BY body_hash
+			WHERE crawl_id = ? AND media_type = "text/html" AND main_text_hash <> ""
+			GROUP BY main_text_hash
 			HAVING COUNT(*) > 1
-		) AND crawl_id = ? AND media_type = "text/html" AND body_hash <> ""`
+		) AND crawl_id = ? AND media_type = "text/html" AND main_text_hash <> ""`
 
 	return &models.MultipageIssueReporter{
 		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id),
 		ErrorType: errors.ErrorDuplicatedContent,
 	}
 }
+
+// Returns a MultipageCallback that creates a MultipageIssueReporter object to report the pages
+// with a text similar to any other page in the crawl. The similarity is the percentage of equal
+// bits in the SimHash of the pages, and it must reach the minSimilarity threshold. Pages with
+// the exact same content are already reported as duplicated content so they are not compared.
+func (sr *SqlReporter) NearDuplicateContent(minSimilarity int) models.MultipageCallback {
+	return func(c *models.Crawl) *models.MultipageIssueReporter {
+		prStream := make(chan int64)
+
+		go func() {
+			defer close(prStream)
+
+			query := `
+				SELECT id, simhash, main_text_hash
+				FROM pagereports
+				WHERE crawl_id = ? AND media_type = "text/html" AND status_code >= 200 AND status_code < 300
+				AND (canonical = "" OR canonical = url) AND crawled = 1 AND simhash <> 0`
+
+			rows, err := sr.db.Query(query, c.Id)
+			if err != nil {
+				log.Printf("NearDuplicateContent: %v\n", err)
+				return
+			}
+			defer rows.Close()
+
+			pages := []simHashPage{}
+			for rows.Next() {
+				p := simHashPage{}
+				if err := rows.Scan(&p.id, &p.simHash, &p.textHash); err != nil {
+					log.Printf("NearDuplicateContent: %v\n", err)
+					continue
+				}
+
+				pages = append(pages, p)
+			}
+
+			for _, id := range nearDuplicates(pages, minSimilarity) {
+				prStream <- id
+			}
+		}()
+
+		return &models.MultipageIssueReporter{
+			Pstream:   prStream,
+			ErrorType: errors.ErrorNearDuplicateContent,
+		}
+	}
+}
+
+// simHashPage contains the SimHash and the main text hash of a page report.
+type simHashPage struct {
+	id       int64
+	simHash  uint64
+	textHash string
+}
+
+// nearDuplicates returns the ids of the pages with a similarity to any other page equal or
+// higher than minSimilarity. Comparing all the pairs is too slow in large crawls, so the
+// SimHashes are split in blocks and only pages with an equal block are compared. If two
+// SimHashes differ in at most k bits and they are split in k+1 blocks, at least one of the
+// blocks has no differing bits, so no near duplicate is missed.
+func nearDuplicates(pages []simHashPage, minSimilarity int) []int64 {
+	// Maximum number of different bits for the pages to reach minSimilarity.
+	k := 64 - (64*minSimilarity+99)/100
+	if k > 63 {
+		k = 63
+	}
+
+	blocks := k + 1
+	size := 64 / blocks
+	duplicated := make([]bool, len(pages))
+
+	for b := 0; b < blocks; b++ {
+		// The last block takes the remaining bits.
+		shift := b * size
+		mask := uint64(1)<<size - 1
+		if b == blocks-1 {
+			mask = ^uint64(0) >> shift
+		}
+
+		buckets := make(map[uint64][]int)
+		for i, p := range pages {
+			key := (p.simHash >> shift) & mask
+			buckets[key] = append(buckets[key], i)
+		}
+
+		for _, bucket := range buckets {
+			for x := 0; x < len(bucket); x++ {
+				for y := x + 1; y < len(bucket); y++ {
+					i, j := bucket[x], bucket[y]
+					if duplicated[i] && duplicated[j] {
+						continue
+					}
+
+					if pages[i].textHash == pages[j].textHash {
+						continue
+					}
+
+					if simhash.Similarity(pages[i].simHash, pages[j].simHash) >= minSimilarity {
+						duplicated[i] = true
+						duplicated[j] = true
+					}
+				}
+			}
+		}
+	}
+
+	ids := []int64{}
+	for i, d := range duplicated {
+		if d {
+			ids = append(ids, pages[i].id)
+		}
+	}
+
+	return ids
+}
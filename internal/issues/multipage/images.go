@@ -0,0 +1,67 @@
+package multipage
+
+import (
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Creates a MultipageIssueReporter object that reports the pages with images which intrinsic
+// width or height is larger than their width or height attributes multiplied by
+// page.MaxImageOversizeRatio. The srcset candidates are not included.
+func (sr *SqlReporter) OversizedImages(c *models.Crawl) *models.MultipageIssueReporter {
+	query := `
+		SELECT DISTINCT images.pagereport_id
+		FROM images
+		INNER JOIN pagereports ON pagereports.url_hash = SHA2(images.url, 256)
+			AND pagereports.crawl_id = images.crawl_id
+			AND pagereports.crawled = 1
+		WHERE images.crawl_id = ? AND images.srcset = 0
+		AND pagereports.image_width > 0 AND pagereports.image_height > 0
+		AND (
+			(images.width > 0 AND pagereports.image_width > images.width * ?)
+			OR (images.height > 0 AND pagereports.image_height > images.height * ?)
+		)`
+
+	return &models.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, page.MaxImageOversizeRatio, page.MaxImageOversizeRatio),
+		ErrorType: errors.ErrorOversizedImage,
+	}
+}
+
+// Creates a MultipageIssueReporter object that reports the pages with images which width and
+// height attributes have an aspect ratio that differs from the image's intrinsic aspect ratio
+// more than page.MaxImageDistortion.
+func (sr *SqlReporter) DistortedImages(c *models.Crawl) *models.MultipageIssueReporter {
+	query := `
+		SELECT DISTINCT images.pagereport_id
+		FROM images
+		INNER JOIN pagereports ON pagereports.url_hash = SHA2(images.url, 256)
+			AND pagereports.crawl_id = images.crawl_id
+			AND pagereports.crawled = 1
+		WHERE images.crawl_id = ? AND images.width > 0 AND images.height > 0
+		AND pagereports.image_width > 0 AND pagereports.image_height > 0
+		AND ABS(images.width * pagereports.image_height - images.height * pagereports.image_width)
+			> ? * images.height * pagereports.image_width`
+
+	return &models.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, page.MaxImageDistortion),
+		ErrorType: errors.ErrorDistortedImage,
+	}
+}
+
+// Creates a MultipageIssueReporter object that reports the pages with srcset candidates
+// returning a 4xx or 5xx status code.
+func (sr *SqlReporter) BrokenSrcset(c *models.Crawl) *models.MultipageIssueReporter {
+	query := `
+		SELECT DISTINCT images.pagereport_id
+		FROM images
+		INNER JOIN pagereports ON pagereports.url_hash = SHA2(images.url, 256)
+			AND pagereports.crawl_id = images.crawl_id
+		WHERE images.crawl_id = ? AND images.srcset = 1 AND pagereports.status_code >= 400`
+
+	return &models.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id),
+		ErrorType: errors.ErrorBrokenSrcset,
+	}
+}
@@ -48,6 +48,28 @@ func (sr *SqlReporter) GetAllReporters() []models.MultipageCallback {
 		// Add canonical issue reporters
 		sr.CanonicalizedToNonCanonical,
 		sr.CanonicalizedToNonIndexable,
+
+		// Add social tags issue reporters
+		sr.BrokenOpenGraphImage,
+
+		// Add image issue reporters
+		sr.OversizedImages,
+		sr.DistortedImages,
+		sr.BrokenSrcset,
+	}
+}
+
+// GetThresholdReporters returns a slice of the reporters in the SqlReporter that depend on the
+// project's issue thresholds.
+func (sr *SqlReporter) GetThresholdReporters(t models.IssueThresholds) []models.MultipageCallback {
+	return []models.MultipageCallback{
+		// Add content issue reporters
+		sr.NearDuplicateContent(t.MinSimilarity),
+
+		// Add page weight issue reporters
+		sr.PageWeightBudget(t.MaxPageWeight),
+		sr.ScriptsWeightBudget(t.MaxScriptsWeight),
+		sr.PageRequestsBudget(t.MaxPageRequests),
 	}
 }
 
@@ -0,0 +1,27 @@
+package multipage
+
+import (
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Creates a MultipageIssueReporter object that contains the SQL query to check for pages
+// with an og:image URL that was crawled and returned an error status code.
+func (sr *SqlReporter) BrokenOpenGraphImage(c *models.Crawl) *models.MultipageIssueReporter {
+	query := `
+		SELECT DISTINCT
+			pr.id
+		FROM pagereports AS pr
+		INNER JOIN social_tags AS st ON st.pagereport_id = pr.id
+		INNER JOIN pagereports AS pr2 ON pr2.url_hash = st.content_hash
+		WHERE pr.crawl_id = ?
+			AND st.crawl_id = ?
+			AND pr2.crawl_id = ?
+			AND st.property = "og:image"
+			AND pr2.status_code >= 400`
+
+	return &models.MultipageIssueReporter{
+		Pstream:   sr.pageReportsQuery(query, c.Id, c.Id, c.Id),
+		ErrorType: errors.ErrorBrokenOpenGraphImage,
+	}
+}
@@ -0,0 +1,54 @@
+package multipage
+
+import (
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// Returns a MultipageCallback that creates a MultipageIssueReporter object to report the pages
+// with a total weight, including their resources, greater than the maxWeight threshold in bytes.
+func (sr *SqlReporter) PageWeightBudget(maxWeight int64) models.MultipageCallback {
+	return func(c *models.Crawl) *models.MultipageIssueReporter {
+		query := `
+			SELECT pagereport_id
+			FROM page_weights
+			WHERE crawl_id = ? AND total > ?`
+
+		return &models.MultipageIssueReporter{
+			Pstream:   sr.pageReportsQuery(query, c.Id, maxWeight),
+			ErrorType: errors.ErrorPageWeightBudget,
+		}
+	}
+}
+
+// Returns a MultipageCallback that creates a MultipageIssueReporter object to report the pages
+// with a scripts weight greater than the maxWeight threshold in bytes.
+func (sr *SqlReporter) ScriptsWeightBudget(maxWeight int64) models.MultipageCallback {
+	return func(c *models.Crawl) *models.MultipageIssueReporter {
+		query := `
+			SELECT pagereport_id
+			FROM page_weights
+			WHERE crawl_id = ? AND scripts > ?`
+
+		return &models.MultipageIssueReporter{
+			Pstream:   sr.pageReportsQuery(query, c.Id, maxWeight),
+			ErrorType: errors.ErrorScriptsWeightBudget,
+		}
+	}
+}
+
+// Returns a MultipageCallback that creates a MultipageIssueReporter object to report the pages
+// loading more scripts, styles, images and fonts than the maxRequests threshold.
+func (sr *SqlReporter) PageRequestsBudget(maxRequests int) models.MultipageCallback {
+	return func(c *models.Crawl) *models.MultipageIssueReporter {
+		query := `
+			SELECT pagereport_id
+			FROM page_weights
+			WHERE crawl_id = ? AND scripts_count + styles_count + images_count + fonts_count > ?`
+
+		return &models.MultipageIssueReporter{
+			Pstream:   sr.pageReportsQuery(query, c.Id, maxRequests),
+			ErrorType: errors.ErrorPageRequestsBudget,
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package page
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+// Input types that don't need a label, either because they are not displayed or
+// because they have a default accessible name.
+var unlabeledInputTypes = map[string]bool{
+	"hidden": true,
+	"submit": true,
+	"reset":  true,
+	"button": true,
+	"image":  true,
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// form inputs, selects or textareas without an associated label, aria-label, aria-labelledby
+// or title attribute.
+func NewInputWithoutLabelReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		labels := make(map[string]bool)
+		for _, l := range htmlquery.Find(htmlNode, "//label[@for]") {
+			labels[htmlquery.SelectAttr(l, "for")] = true
+		}
+
+		ids := elementIds(htmlNode)
+		for _, n := range htmlquery.Find(htmlNode, "//input | //select | //textarea") {
+			if n.Data == "input" && unlabeledInputTypes[strings.ToLower(htmlquery.SelectAttr(n, "type"))] {
+				continue
+			}
+
+			if isAriaHidden(n) {
+				continue
+			}
+
+			id := htmlquery.SelectAttr(n, "id")
+			if id != "" && labels[id] {
+				continue
+			}
+
+			if hasLabelAncestor(n) {
+				continue
+			}
+
+			if labelledByText(n, ids) != "" || attrText(n, "aria-label", "title") != "" {
+				continue
+			}
+
+			return true
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorInputWithoutLabel,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// buttons without an accessible name. Submit and reset inputs have a default name so they
+// are not reported.
+func NewButtonWithoutNameReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		ids := elementIds(htmlNode)
+		for _, n := range htmlquery.Find(htmlNode, "//button | //input[@type]") {
+			if isAriaHidden(n) || labelledByText(n, ids) != "" {
+				continue
+			}
+
+			name := ""
+			switch strings.ToLower(htmlquery.SelectAttr(n, "type")) {
+			case "button":
+				if n.Data == "button" {
+					name = anchorText(n)
+				} else {
+					name = attrText(n, "value", "aria-label", "title")
+				}
+			case "image":
+				name = attrText(n, "alt", "aria-label", "title")
+			default:
+				if n.Data != "button" {
+					continue
+				}
+
+				name = anchorText(n)
+			}
+
+			if name == "" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorButtonWithoutName,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// links with an anchor text that is hidden from assistive technologies, so they don't have
+// an accessible name. The accessible name is the text of the link's aria-labelledby elements,
+// its aria-label, its visible text and image alt texts or its title. Links without any anchor
+// text are already reported as empty anchor text or image links without alt text.
+func NewLinkWithoutNameReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		ids := elementIds(htmlNode)
+		for _, a := range htmlquery.Find(htmlNode, "//a[@href]") {
+			if isAriaHidden(a) || anchorText(a) == "" {
+				continue
+			}
+
+			if labelledByText(a, ids) != "" || attrText(a, "aria-label") != "" {
+				continue
+			}
+
+			if strings.TrimSpace(accessibleContent(a)) == "" && attrText(a, "title") == "" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorLinkWithoutName,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// aria-labelledby attributes referencing ids that don't exist or that are used by more than
+// one element.
+func NewInvalidAriaLabelledByReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		ids := elementIds(htmlNode)
+		for _, n := range htmlquery.Find(htmlNode, "//*[@aria-labelledby]") {
+			for _, id := range strings.Fields(htmlquery.SelectAttr(n, "aria-labelledby")) {
+				if len(ids[id]) != 1 {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorInvalidAriaLabelledBy,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// elements with a tabindex greater than 0, which changes the natural keyboard navigation order.
+func NewPositiveTabindexReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, n := range htmlquery.Find(htmlNode, "//*[@tabindex]") {
+			tabindex, err := strconv.Atoi(strings.TrimSpace(htmlquery.SelectAttr(n, "tabindex")))
+			if err == nil && tabindex > 0 {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorPositiveTabindex,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// iframes without a title, aria-label or aria-labelledby attribute.
+func NewIframeWithoutTitleReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		ids := elementIds(htmlNode)
+		for _, n := range htmlquery.Find(htmlNode, "//iframe") {
+			if isAriaHidden(n) {
+				continue
+			}
+
+			if attrText(n, "title", "aria-label") == "" && labelledByText(n, ids) == "" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorIframeWithoutTitle,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// data tables without header cells. Tables with the presentation or none roles are layout
+// tables and are not reported.
+func NewTableWithoutHeadersReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, n := range htmlquery.Find(htmlNode, "//table") {
+			role := strings.ToLower(strings.TrimSpace(htmlquery.SelectAttr(n, "role")))
+			if role == "presentation" || role == "none" {
+				continue
+			}
+
+			if htmlquery.FindOne(n, ".//th | .//*[@role='columnheader' or @role='rowheader']") == nil {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorTableWithoutHeaders,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page's viewport
+// meta tag disables zooming with user-scalable=no.
+func NewUserScalableDisabledReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, n := range htmlquery.Find(htmlNode, "//head/meta[@name=\"viewport\"]") {
+			for _, p := range strings.Split(htmlquery.SelectAttr(n, "content"), ",") {
+				k, v, found := strings.Cut(p, "=")
+				if !found || strings.ToLower(strings.TrimSpace(k)) != "user-scalable" {
+					continue
+				}
+
+				v = strings.ToLower(strings.TrimSpace(v))
+				if v == "no" || v == "0" {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorUserScalableDisabled,
+		Callback:  c,
+	}
+}
+
+// elementIds returns the elements in the document grouped by their id attribute.
+func elementIds(doc *html.Node) map[string][]*html.Node {
+	ids := make(map[string][]*html.Node)
+	for _, n := range htmlquery.Find(doc, "//*[@id]") {
+		id := htmlquery.SelectAttr(n, "id")
+		ids[id] = append(ids[id], n)
+	}
+
+	return ids
+}
+
+// labelledByText returns the text of the elements referenced by the element's aria-labelledby
+// attribute. Ids that don't exist in the document are ignored.
+func labelledByText(n *html.Node, ids map[string][]*html.Node) string {
+	texts := []string{}
+	for _, id := range strings.Fields(htmlquery.SelectAttr(n, "aria-labelledby")) {
+		for _, l := range ids[id] {
+			if t := strings.TrimSpace(htmlquery.InnerText(l)); t != "" {
+				texts = append(texts, t)
+			}
+		}
+	}
+
+	return strings.Join(texts, " ")
+}
+
+// attrText returns the trimmed value of the first of the element's attributes that isn't empty.
+func attrText(n *html.Node, attrs ...string) string {
+	for _, attr := range attrs {
+		if t := strings.TrimSpace(htmlquery.SelectAttr(n, attr)); t != "" {
+			return t
+		}
+	}
+
+	return ""
+}
+
+// accessibleContent returns the text and image alt texts inside the element, without the
+// content of the descendants hidden with the aria-hidden attribute.
+func accessibleContent(n *html.Node) string {
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch c.Type {
+		case html.TextNode:
+			sb.WriteString(c.Data)
+		case html.ElementNode:
+			if strings.ToLower(htmlquery.SelectAttr(c, "aria-hidden")) == "true" {
+				continue
+			}
+
+			if c.Data == "img" {
+				sb.WriteString(" " + htmlquery.SelectAttr(c, "alt") + " ")
+				continue
+			}
+
+			sb.WriteString(accessibleContent(c))
+		}
+	}
+
+	return sb.String()
+}
+
+// hasLabelAncestor returns true if the element is inside a label element.
+func hasLabelAncestor(n *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Type == html.ElementNode && p.Data == "label" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAriaHidden returns true if the element or any of its ancestors is hidden from assistive
+// technologies with the aria-hidden attribute.
+func isAriaHidden(n *html.Node) bool {
+	for p := n; p != nil; p = p.Parent {
+		if p.Type == html.ElementNode && strings.ToLower(htmlquery.SelectAttr(p, "aria-hidden")) == "true" {
+			return true
+		}
+	}
+
+	return false
+}
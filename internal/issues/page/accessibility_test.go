@@ -0,0 +1,577 @@
+package page_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the InputWithoutLabel reporter with a page with form inputs with labels.
+// The reporter should not report the issue.
+func TestInputWithoutLabelNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<label for="email">Email</label><input type="email" id="email">
+			<label>Name <input type="text"></label>
+			<input type="search" aria-label="Search">
+			<input type="hidden" name="token">
+			<input type="submit">
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewInputWithoutLabelReporter()
+	if reporter.ErrorType != errors.ErrorInputWithoutLabel {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestInputWithoutLabelNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InputWithoutLabel reporter with a page with a text input without a label.
+// The reporter should report the issue.
+func TestInputWithoutLabelIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<label for="email">Email</label><input type="email" id="email">
+			<input type="text" name="name" placeholder="Name">
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewInputWithoutLabelReporter()
+	if reporter.ErrorType != errors.ErrorInputWithoutLabel {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestInputWithoutLabelIssues: reportsIssue should be true")
+	}
+}
+
+// Test the ButtonWithoutName reporter with a page with buttons with accessible names.
+// The reporter should not report the issue.
+func TestButtonWithoutNameNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<button>Send</button>
+			<button aria-label="Close"><svg></svg></button>
+			<input type="submit">
+			<input type="image" src="go.png" alt="Go">
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewButtonWithoutNameReporter()
+	if reporter.ErrorType != errors.ErrorButtonWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestButtonWithoutNameNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ButtonWithoutName reporter with a page with an icon-only button without a name.
+// The reporter should report the issue.
+func TestButtonWithoutNameIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<button>Send</button>
+			<button><svg></svg></button>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewButtonWithoutNameReporter()
+	if reporter.ErrorType != errors.ErrorButtonWithoutName {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestButtonWithoutNameIssues: reportsIssue should be true")
+	}
+}
+
+// Test the LinkWithoutName reporter with a page with links with accessible names.
+// The reporter should not report the issue.
+func TestLinkWithoutNameNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<a href="/a">Products</a>
+			<span id="cart-label">Cart</span><a href="/cart" aria-labelledby="cart-label"><span aria-hidden="true">C</span></a>
+			<a href="/home"><img src="logo.png" alt="Home"></a>
+			<a href="/next" aria-label="Next page"><span aria-hidden="true">&rarr;</span></a>
+			<a href="/empty"><svg></svg></a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewLinkWithoutNameReporter()
+	if reporter.ErrorType != errors.ErrorLinkWithoutName {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestLinkWithoutNameNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the LinkWithoutName reporter with a page with a link without an accessible name.
+// The reporter should report the issue.
+func TestLinkWithoutNameIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<a href="/a">Products</a>
+			<a href="/next"><span aria-hidden="true">&rarr;</span></a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewLinkWithoutNameReporter()
+	if reporter.ErrorType != errors.ErrorLinkWithoutName {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestLinkWithoutNameIssues: reportsIssue should be true")
+	}
+}
+
+// Test the InvalidAriaLabelledBy reporter with a page with valid aria-labelledby references.
+// The reporter should not report the issue.
+func TestInvalidAriaLabelledByNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<h2 id="title">Title</h2>
+			<section aria-labelledby="title"></section>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewInvalidAriaLabelledByReporter()
+	if reporter.ErrorType != errors.ErrorInvalidAriaLabelledBy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestInvalidAriaLabelledByNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidAriaLabelledBy reporter with a page with an aria-labelledby referencing a missing id.
+// The reporter should report the issue.
+func TestInvalidAriaLabelledByIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<h2 id="title">Title</h2>
+			<section aria-labelledby="title subtitle"></section>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewInvalidAriaLabelledByReporter()
+	if reporter.ErrorType != errors.ErrorInvalidAriaLabelledBy {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestInvalidAriaLabelledByIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PositiveTabindex reporter with a page with tabindex values of 0 and -1.
+// The reporter should not report the issue.
+func TestPositiveTabindexNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<div tabindex="0">Focusable</div>
+			<div tabindex="-1">Not in order</div>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewPositiveTabindexReporter()
+	if reporter.ErrorType != errors.ErrorPositiveTabindex {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestPositiveTabindexNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PositiveTabindex reporter with a page with an element with a tabindex greater than 0.
+// The reporter should report the issue.
+func TestPositiveTabindexIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<div tabindex="0">Focusable</div>
+			<a href="/" tabindex="3">First</a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewPositiveTabindexReporter()
+	if reporter.ErrorType != errors.ErrorPositiveTabindex {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestPositiveTabindexIssues: reportsIssue should be true")
+	}
+}
+
+// Test the IframeWithoutTitle reporter with a page with iframes with a title.
+// The reporter should not report the issue.
+func TestIframeWithoutTitleNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<iframe src="/map" title="Office location map"></iframe>
+			<iframe src="/tracking" aria-hidden="true"></iframe>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewIframeWithoutTitleReporter()
+	if reporter.ErrorType != errors.ErrorIframeWithoutTitle {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestIframeWithoutTitleNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the IframeWithoutTitle reporter with a page with an iframe without a title.
+// The reporter should report the issue.
+func TestIframeWithoutTitleIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<iframe src="/map"></iframe>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewIframeWithoutTitleReporter()
+	if reporter.ErrorType != errors.ErrorIframeWithoutTitle {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestIframeWithoutTitleIssues: reportsIssue should be true")
+	}
+}
+
+// Test the TableWithoutHeaders reporter with a page with a data table with headers and a layout table.
+// The reporter should not report the issue.
+func TestTableWithoutHeadersNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<table><tr><th>Name</th></tr><tr><td>Value</td></tr></table>
+			<table role="presentation"><tr><td>Layout</td></tr></table>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewTableWithoutHeadersReporter()
+	if reporter.ErrorType != errors.ErrorTableWithoutHeaders {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestTableWithoutHeadersNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the TableWithoutHeaders reporter with a page with a data table without header cells.
+// The reporter should report the issue.
+func TestTableWithoutHeadersIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head></head>
+		<body>
+			<table><tr><td>Name</td></tr><tr><td>Value</td></tr></table>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewTableWithoutHeadersReporter()
+	if reporter.ErrorType != errors.ErrorTableWithoutHeaders {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestTableWithoutHeadersIssues: reportsIssue should be true")
+	}
+}
+
+// Test the UserScalableDisabled reporter with a page with a viewport that allows zooming.
+// The reporter should not report the issue.
+func TestUserScalableDisabledNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head><meta name="viewport" content="width=device-width, initial-scale=1"></head>
+		<body>
+			<p>Text</p>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewUserScalableDisabledReporter()
+	if reporter.ErrorType != errors.ErrorUserScalableDisabled {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestUserScalableDisabledNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the UserScalableDisabled reporter with a page with a viewport with user-scalable=no.
+// The reporter should report the issue.
+func TestUserScalableDisabledIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<head><meta name="viewport" content="width=device-width, initial-scale=1, user-scalable=no"></head>
+		<body>
+			<p>Text</p>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewUserScalableDisabledReporter()
+	if reporter.ErrorType != errors.ErrorUserScalableDisabled {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestUserScalableDisabledIssues: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,194 @@
+package page
+
+import (
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// DefaultGenericAnchors contains the generic anchor texts for each language. Generic anchors
+// don't describe the linked page. Projects can add their own generic anchors to these ones.
+var DefaultGenericAnchors = map[string][]string{
+	"en": {"click here", "here", "read more", "more", "learn more", "more info", "link", "this link", "continue", "continue reading", "go", "details", "see more", "find out more"},
+	"es": {"haz clic aquí", "haga clic aquí", "pulsa aquí", "clic aquí", "aquí", "leer más", "más", "más información", "ver más", "enlace", "seguir leyendo", "continuar"},
+	"fr": {"cliquez ici", "ici", "lire la suite", "en savoir plus", "plus", "voir plus", "lien", "suite", "continuer"},
+	"de": {"hier klicken", "klicken sie hier", "hier", "mehr", "weiterlesen", "mehr erfahren", "mehr lesen", "link", "weiter"},
+	"it": {"clicca qui", "qui", "leggi di più", "leggi tutto", "scopri di più", "altro", "continua", "link"},
+	"pt": {"clique aqui", "aqui", "leia mais", "saiba mais", "mais", "ver mais", "link", "continuar"},
+	"nl": {"klik hier", "hier", "lees meer", "meer", "meer informatie", "link", "verder lezen"},
+	"ca": {"fes clic aquí", "clica aquí", "aquí", "llegir més", "més", "més informació", "veure més", "enllaç"},
+}
+
+// GenericAnchors returns the default generic anchors with the project's generic anchors added
+// to them. The anchor texts are normalized so they can be compared with the links' anchor text.
+func GenericAnchors(custom []models.Phrase) map[string][]string {
+	return phrasesByLang(DefaultGenericAnchors, custom)
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// links without anchor text, aria-label, title or images.
+func NewEmptyAnchorTextReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, a := range htmlquery.Find(htmlNode, "//a[@href]") {
+			if anchorText(a) == "" && htmlquery.FindOne(a, ".//img") == nil {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorEmptyAnchorText,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// links with a generic anchor text in the page's language. If the page language doesn't have
+// generic anchors the anchors of all the languages are used.
+func NewGenericAnchorTextReporter(anchors map[string][]string) *models.PageIssueReporter {
+	all := make(map[string]bool)
+	for _, texts := range anchors {
+		for _, t := range texts {
+			all[t] = true
+		}
+	}
+
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		generic := all
+		if texts, ok := anchors[PrimaryLang(pageReport.Lang)]; ok {
+			generic = make(map[string]bool, len(texts))
+			for _, t := range texts {
+				generic[t] = true
+			}
+		}
+
+		for _, a := range htmlquery.Find(htmlNode, "//a[@href]") {
+			if generic[normalizeAnchorText(anchorText(a))] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorGenericAnchorText,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page contains
+// links whose only content is an image without alt text.
+func NewImageLinkWithoutAltReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, a := range htmlquery.Find(htmlNode, "//a[@href]") {
+			if anchorText(a) == "" && htmlquery.FindOne(a, ".//img") != nil {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorImageLinkWithoutAlt,
+		Callback:  c,
+	}
+}
+
+// isCrawledHTML returns true if the page is a crawled html page with a 2xx status code.
+func isCrawledHTML(pageReport *models.PageReport) bool {
+	if !pageReport.Crawled {
+		return false
+	}
+
+	if pageReport.MediaType != "text/html" {
+		return false
+	}
+
+	return pageReport.StatusCode >= 200 && pageReport.StatusCode < 300
+}
+
+// anchorText returns the text of a link as read by search engines and screen readers.
+// It uses the link's text, its aria-label or title attributes or the alt text of its images.
+func anchorText(a *html.Node) string {
+	if t := strings.TrimSpace(htmlquery.InnerText(a)); t != "" {
+		return t
+	}
+
+	for _, attr := range []string{"aria-label", "title"} {
+		if t := strings.TrimSpace(htmlquery.SelectAttr(a, attr)); t != "" {
+			return t
+		}
+	}
+
+	for _, img := range htmlquery.Find(a, ".//img") {
+		if t := strings.TrimSpace(htmlquery.SelectAttr(img, "alt")); t != "" {
+			return t
+		}
+	}
+
+	return ""
+}
+
+// phrasesByLang returns the default phrases with the project's phrases added to them, grouped
+// by their primary language. The phrases are normalized so they can be compared with the texts
+// in the pages.
+func phrasesByLang(defaults map[string][]string, custom []models.Phrase) map[string][]string {
+	phrases := make(map[string][]string)
+	for lang, texts := range defaults {
+		for _, t := range texts {
+			phrases[lang] = append(phrases[lang], normalizeAnchorText(t))
+		}
+	}
+
+	for _, p := range custom {
+		lang := PrimaryLang(p.Lang)
+		phrases[lang] = append(phrases[lang], normalizeAnchorText(p.Text))
+	}
+
+	return phrases
+}
+
+// normalizeAnchorText returns the anchor text in lowercase without punctuation
+// or symbols and with its words separated by a single space.
+func normalizeAnchorText(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
+// PrimaryLang returns the primary language subtag of a language tag in lowercase.
+func PrimaryLang(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	return lang
+}
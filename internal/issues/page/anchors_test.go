@@ -0,0 +1,220 @@
+package page_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the EmptyAnchorText reporter with a page where all the links have an accessible text.
+// The reporter should not report the issue.
+func TestEmptyAnchorTextNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<body>
+			<a href="/a">Products</a>
+			<a href="/b" aria-label="Cart"><svg></svg></a>
+			<a href="/c"><img src="logo.png"></a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewEmptyAnchorTextReporter()
+	if reporter.ErrorType != errors.ErrorEmptyAnchorText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestEmptyAnchorTextNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the EmptyAnchorText reporter with a page that has a link without text.
+// The reporter should report the issue.
+func TestEmptyAnchorTextIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<body>
+			<a href="/a">Products</a>
+			<a href="/b"> <svg></svg> </a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewEmptyAnchorTextReporter()
+	if reporter.ErrorType != errors.ErrorEmptyAnchorText {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestEmptyAnchorTextIssues: reportsIssue should be true")
+	}
+}
+
+// Test the GenericAnchorText reporter with a page with descriptive anchor texts and a
+// generic anchor text from another language. The reporter should not report the issue.
+func TestGenericAnchorTextNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "en-US",
+	}
+
+	source := `
+	<html>
+		<body>
+			<a href="/a">Read more about our products</a>
+			<a href="/b">Leer más</a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewGenericAnchorTextReporter(page.GenericAnchors(nil))
+	if reporter.ErrorType != errors.ErrorGenericAnchorText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestGenericAnchorTextNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the GenericAnchorText reporter with a page with a default generic anchor text and a
+// page with a project's generic anchor text. The reporter should report the issue.
+func TestGenericAnchorTextIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "en",
+	}
+
+	reporter := page.NewGenericAnchorTextReporter(page.GenericAnchors([]models.Phrase{
+		{Lang: "EN", Text: "Check it out"},
+	}))
+	if reporter.ErrorType != errors.ErrorGenericAnchorText {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	sources := []string{
+		`<html><body><a href="/a">Click here »</a></body></html>`,
+		`<html><body><a href="/a">check it out!</a></body></html>`,
+	}
+
+	for _, source := range sources {
+		doc, err := html.Parse(strings.NewReader(source))
+		if err != nil {
+			t.Errorf("error parsing html source")
+		}
+
+		reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+		if reportsIssue == false {
+			t.Errorf("TestGenericAnchorTextIssues: reportsIssue should be true for %s", source)
+		}
+	}
+}
+
+// Test the ImageLinkWithoutAlt reporter with a page where the image links have alt text.
+// The reporter should not report the issue.
+func TestImageLinkWithoutAltNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<body>
+			<a href="/a"><img src="a.png" alt="Products"></a>
+			<a href="/b"><img src="b.png">Services</a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewImageLinkWithoutAltReporter()
+	if reporter.ErrorType != errors.ErrorImageLinkWithoutAlt {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestImageLinkWithoutAltNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ImageLinkWithoutAlt reporter with a page that has an image link without alt text.
+// The reporter should report the issue.
+func TestImageLinkWithoutAltIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `
+	<html>
+		<body>
+			<a href="/a"><img src="a.png" alt=""></a>
+		</body>
+	</html>`
+
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewImageLinkWithoutAltReporter()
+	if reporter.ErrorType != errors.ErrorImageLinkWithoutAlt {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestImageLinkWithoutAltIssues: reportsIssue should be true")
+	}
+}
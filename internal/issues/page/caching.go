@@ -0,0 +1,184 @@
+package page
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Static resources cached by the browser for less seconds than this, one week, are downloaded
+// again too often.
+const MinStaticCacheMaxAge = 7 * 24 * 60 * 60
+
+// Text responses smaller than this number of bytes don't benefit from compression.
+const MinCompressSize = 1024
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page is a script, style, image or font with a status code between 200 and 299, and its
+// Cache-Control header is missing or doesn't let browsers cache it for at least MinStaticCacheMaxAge
+// seconds, because it contains no-store, no-cache or a shorter max-age.
+func NewStaticCacheControlReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled || !isStaticResource(pageReport.MediaType) {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		directives := cacheControl(header.Get("Cache-Control"))
+		if _, ok := directives["no-store"]; ok {
+			return true
+		}
+
+		if _, ok := directives["no-cache"]; ok {
+			return true
+		}
+
+		maxAge, err := strconv.Atoi(directives["max-age"])
+		if err != nil {
+			return true
+		}
+
+		return maxAge < MinStaticCacheMaxAge
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorStaticCacheControl,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the Cache-Control header
+// contains no-store without a reason for it. Pages that set cookies or are noindex may contain
+// personal data, so they are not reported.
+func NewHTMLNoStoreReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) || pageReport.Noindex {
+			return false
+		}
+
+		if header.Get("Set-Cookie") != "" {
+			return false
+		}
+
+		_, ok := cacheControl(header.Get("Cache-Control"))["no-store"]
+
+		return ok
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorHTMLNoStore,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page is an HTML page or a static resource with a status code between 200 and 299 and the
+// response has neither an ETag nor a Last-Modified header, so browsers can't revalidate their
+// cached copy without downloading it again. Responses with no-store are not cached at all, so
+// they are not reported.
+func NewMissingValidatorsReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" && !isStaticResource(pageReport.MediaType) {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		if _, ok := cacheControl(header.Get("Cache-Control"))["no-store"]; ok {
+			return false
+		}
+
+		return header.Get("ETag") == "" && header.Get("Last-Modified") == ""
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorMissingValidators,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page is a text response, such as HTML, CSS, JavaScript, JSON, XML or SVG, with a status code
+// between 200 and 299 and at least MinCompressSize bytes, and it was not compressed even though
+// the crawler accepts compressed responses.
+func NewUncompressedTextReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled || !isTextResponse(pageReport.MediaType) {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		if pageReport.Size < MinCompressSize {
+			return false
+		}
+
+		switch strings.ToLower(strings.TrimSpace(header.Get("Content-Encoding"))) {
+		case "gzip", "x-gzip", "br", "deflate", "zstd":
+			return false
+		}
+
+		return true
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorUncompressedText,
+		Callback:  c,
+	}
+}
+
+// cacheControl returns the directives of a Cache-Control header in lowercase, mapped to their
+// values. Directives without a value are mapped to an empty string.
+func cacheControl(h string) map[string]string {
+	directives := make(map[string]string)
+	for _, d := range strings.Split(h, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(d), "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		directives[name] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+
+	return directives
+}
+
+// isStaticResource returns true if the media type is a script, a style, an image or a font.
+func isStaticResource(mediaType string) bool {
+	switch mediaType {
+	case "text/css", "application/javascript", "text/javascript", "application/x-javascript",
+		"application/font-woff", "application/x-font-ttf", "application/x-font-otf", "application/vnd.ms-fontobject":
+		return true
+	}
+
+	return strings.HasPrefix(mediaType, "image/") || strings.HasPrefix(mediaType, "font/")
+}
+
+// isTextResponse returns true if the media type is a text format that can be compressed.
+func isTextResponse(mediaType string) bool {
+	switch mediaType {
+	case "application/javascript", "application/x-javascript", "application/json", "application/ld+json",
+		"application/xml", "application/rss+xml", "application/atom+xml", "image/svg+xml":
+		return true
+	}
+
+	return strings.HasPrefix(mediaType, "text/")
+}
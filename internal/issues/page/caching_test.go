@@ -0,0 +1,205 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the StaticCacheControl reporter with a script cached for a year.
+// The reporter should not report the issue.
+func TestStaticCacheControlNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "application/javascript",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Cache-Control", "public, max-age=31536000, immutable")
+
+	reporter := page.NewStaticCacheControlReporter()
+	if reporter.ErrorType != errors.ErrorStaticCacheControl {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestStaticCacheControlNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the StaticCacheControl reporter with images without Cache-Control, with no-cache and
+// with a short max-age. The reporter should report the issue.
+func TestStaticCacheControlIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "image/png",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewStaticCacheControlReporter()
+	if reporter.ErrorType != errors.ErrorStaticCacheControl {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	for _, v := range []string{"", "no-cache", "max-age=600"} {
+		header := &http.Header{}
+		if v != "" {
+			header.Set("Cache-Control", v)
+		}
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == false {
+			t.Errorf("TestStaticCacheControlIssues: reportsIssue should be true with Cache-Control %q", v)
+		}
+	}
+}
+
+// Test the HTMLNoStore reporter with a no-store page that sets a cookie.
+// The reporter should not report the issue.
+func TestHTMLNoStoreNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Cache-Control", "no-store")
+	header.Set("Set-Cookie", "session=1234; HttpOnly")
+
+	reporter := page.NewHTMLNoStoreReporter()
+	if reporter.ErrorType != errors.ErrorHTMLNoStore {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestHTMLNoStoreNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HTMLNoStore reporter with an indexable no-store page without cookies.
+// The reporter should report the issue.
+func TestHTMLNoStoreIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Cache-Control", "private, No-Store")
+
+	reporter := page.NewHTMLNoStoreReporter()
+	if reporter.ErrorType != errors.ErrorHTMLNoStore {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == false {
+		t.Errorf("TestHTMLNoStoreIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingValidators reporter with a page that has an ETag.
+// The reporter should not report the issue.
+func TestMissingValidatorsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("ETag", `"33a64df551425fcc55e4d42a148795d9f25f89d4"`)
+
+	reporter := page.NewMissingValidatorsReporter()
+	if reporter.ErrorType != errors.ErrorMissingValidators {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestMissingValidatorsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingValidators reporter with a stylesheet without ETag and Last-Modified.
+// The reporter should report the issue.
+func TestMissingValidatorsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/css",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewMissingValidatorsReporter()
+	if reporter.ErrorType != errors.ErrorMissingValidators {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestMissingValidatorsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the UncompressedText reporter with a page compressed with brotli.
+// The reporter should not report the issue.
+func TestUncompressedTextNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Size:       50000,
+	}
+
+	header := &http.Header{}
+	header.Set("Content-Encoding", "br")
+
+	reporter := page.NewUncompressedTextReporter()
+	if reporter.ErrorType != errors.ErrorUncompressedText {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestUncompressedTextNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the UncompressedText reporter with an uncompressed script.
+// The reporter should report the issue.
+func TestUncompressedTextIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/javascript",
+		StatusCode: 200,
+		Size:       50000,
+	}
+
+	reporter := page.NewUncompressedTextReporter()
+	if reporter.ErrorType != errors.ErrorUncompressedText {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestUncompressedTextIssues: reportsIssue should be true")
+	}
+}
@@ -15,8 +15,8 @@ import (
 
 // Returns a report_manager.PageIssueReporter with a callback function that
 // checks if a page has little content. The callback returns true if the page is text/html,
-// has a 20x status code and less than a specified amount of words.
-func NewLittleContentReporter() *models.PageIssueReporter {
+// has a 20x status code and less than a specified amount of words in its main content.
+func NewLittleContentReporter(words int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -30,7 +30,7 @@ func NewLittleContentReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return pageReport.Words < 200
+		return pageReport.MainWords < words
 	}
 
 	return &models.PageIssueReporter{
@@ -21,10 +21,10 @@ func TestLittelContentNoIssues(t *testing.T) {
 		Crawled:    true,
 		MediaType:  "text/html",
 		StatusCode: 200,
-		Words:      300,
+		MainWords:  300,
 	}
 
-	reporter := page.NewLittleContentReporter()
+	reporter := page.NewLittleContentReporter(200)
 	if reporter.ErrorType != errors.ErrorLittleContent {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -43,10 +43,10 @@ func TestLittleContentIssues(t *testing.T) {
 		Crawled:    true,
 		MediaType:  "text/html",
 		StatusCode: 200,
-		Words:      30,
+		MainWords:  30,
 	}
 
-	reporter := page.NewLittleContentReporter()
+	reporter := page.NewLittleContentReporter(200)
 	if reporter.ErrorType != errors.ErrorLittleContent {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
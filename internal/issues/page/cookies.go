@@ -0,0 +1,105 @@
+package page
+
+import (
+	"net/http"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page was crawled using HTTPS and any of the cookies in its Set-Cookie headers doesn't have
+// the Secure attribute, so the browser may send it over insecure connections.
+func NewCookieWithoutSecureReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled || pageReport.ParsedURL == nil || pageReport.ParsedURL.Scheme != "https" {
+			return false
+		}
+
+		for _, cookie := range setCookies(header) {
+			if !cookie.Secure {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorCookieWithoutSecure,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// any of the cookies in the page's Set-Cookie headers doesn't have the HttpOnly attribute, so
+// it can be read by scripts running in the page.
+func NewCookieWithoutHttpOnlyReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		for _, cookie := range setCookies(header) {
+			if !cookie.HttpOnly {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorCookieWithoutHttpOnly,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// any of the cookies in the page's Set-Cookie headers doesn't have a valid SameSite attribute,
+// or it has SameSite=None without the Secure attribute, which browsers reject.
+func NewCookieWithoutSameSiteReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		for _, cookie := range setCookies(header) {
+			switch cookie.SameSite {
+			case http.SameSiteLaxMode, http.SameSiteStrictMode:
+				continue
+			case http.SameSiteNoneMode:
+				if cookie.Secure {
+					continue
+				}
+			}
+
+			return true
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorCookieWithoutSameSite,
+		Callback:  c,
+	}
+}
+
+// setCookies returns the cookies in the Set-Cookie headers. Headers that can't be parsed
+// are ignored.
+func setCookies(header *http.Header) []*http.Cookie {
+	cookies := []*http.Cookie{}
+	for _, v := range header.Values("Set-Cookie") {
+		cookie, err := http.ParseSetCookie(v)
+		if err != nil {
+			continue
+		}
+
+		cookies = append(cookies, cookie)
+	}
+
+	return cookies
+}
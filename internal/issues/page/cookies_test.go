@@ -0,0 +1,113 @@
+package page_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the cookie reporters with a page that sets a cookie with all the attributes.
+// The reporters should not report the issue.
+func TestCookieAttributesNoIssues(t *testing.T) {
+	u, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		ParsedURL:  u,
+	}
+
+	header := &http.Header{}
+	header.Add("Set-Cookie", "session=1234; Path=/; Secure; HttpOnly; SameSite=Lax")
+	header.Add("Set-Cookie", "tracking=5678; Secure; HttpOnly; SameSite=None")
+
+	reporters := []*models.PageIssueReporter{
+		page.NewCookieWithoutSecureReporter(),
+		page.NewCookieWithoutHttpOnlyReporter(),
+		page.NewCookieWithoutSameSiteReporter(),
+	}
+
+	for _, reporter := range reporters {
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == true {
+			t.Errorf("TestCookieAttributesNoIssues: reporter %d reportsIssue should be false", reporter.ErrorType)
+		}
+	}
+}
+
+// Test the CookieWithoutSecure reporter with an HTTPS page setting a cookie without Secure.
+// The reporter should report the issue.
+func TestCookieWithoutSecureIssues(t *testing.T) {
+	u, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		ParsedURL: u,
+	}
+
+	header := &http.Header{}
+	header.Add("Set-Cookie", "session=1234; HttpOnly; SameSite=Strict")
+
+	reporter := page.NewCookieWithoutSecureReporter()
+	if reporter.ErrorType != errors.ErrorCookieWithoutSecure {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == false {
+		t.Errorf("TestCookieWithoutSecureIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CookieWithoutHttpOnly reporter with a page setting a cookie without HttpOnly.
+// The reporter should report the issue.
+func TestCookieWithoutHttpOnlyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+	}
+
+	header := &http.Header{}
+	header.Add("Set-Cookie", "session=1234; Secure; SameSite=Strict")
+
+	reporter := page.NewCookieWithoutHttpOnlyReporter()
+	if reporter.ErrorType != errors.ErrorCookieWithoutHttpOnly {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == false {
+		t.Errorf("TestCookieWithoutHttpOnlyIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CookieWithoutSameSite reporter with cookies without SameSite and with SameSite=None
+// without Secure. The reporter should report the issue.
+func TestCookieWithoutSameSiteIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+	}
+
+	reporter := page.NewCookieWithoutSameSiteReporter()
+	if reporter.ErrorType != errors.ErrorCookieWithoutSameSite {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	for _, v := range []string{"session=1234; Secure; HttpOnly", "session=1234; HttpOnly; SameSite=None"} {
+		header := &http.Header{}
+		header.Add("Set-Cookie", v)
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == false {
+			t.Errorf("TestCookieWithoutSameSiteIssues: reportsIssue should be true with %q", v)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package page
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+// CustomIssueFields contains the PageReport fields that can be used in the custom issue rules'
+// conditions, with a function that returns the field's value as a string.
+var CustomIssueFields = map[string]func(*models.PageReport) string{
+	"url":            func(p *models.PageReport) string { return p.URL },
+	"status_code":    func(p *models.PageReport) string { return strconv.Itoa(p.StatusCode) },
+	"content_type":   func(p *models.PageReport) string { return p.ContentType },
+	"media_type":     func(p *models.PageReport) string { return p.MediaType },
+	"lang":           func(p *models.PageReport) string { return p.Lang },
+	"title":          func(p *models.PageReport) string { return p.Title },
+	"description":    func(p *models.PageReport) string { return p.Description },
+	"robots":         func(p *models.PageReport) string { return p.Robots },
+	"canonical":      func(p *models.PageReport) string { return p.Canonical },
+	"redirect_url":   func(p *models.PageReport) string { return p.RedirectURL },
+	"h1":             func(p *models.PageReport) string { return p.H1 },
+	"h2":             func(p *models.PageReport) string { return p.H2 },
+	"words":          func(p *models.PageReport) string { return strconv.Itoa(p.Words) },
+	"main_words":     func(p *models.PageReport) string { return strconv.Itoa(p.MainWords) },
+	"size":           func(p *models.PageReport) string { return strconv.FormatInt(p.Size, 10) },
+	"ttfb":           func(p *models.PageReport) string { return strconv.Itoa(p.TTFB) },
+	"depth":          func(p *models.PageReport) string { return strconv.Itoa(p.Depth) },
+	"links":          func(p *models.PageReport) string { return strconv.Itoa(len(p.Links)) },
+	"external_links": func(p *models.PageReport) string { return strconv.Itoa(len(p.ExternalLinks)) },
+	"images":         func(p *models.PageReport) string { return strconv.Itoa(len(p.Images)) },
+	"scripts":        func(p *models.PageReport) string { return strconv.Itoa(len(p.Scripts)) },
+	"styles":         func(p *models.PageReport) string { return strconv.Itoa(len(p.Styles)) },
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page matches
+// the custom issue rule's condition. The issue is reported using the rule's own issue type.
+func NewCustomIssueReporter(rule models.CustomIssueRule) *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		switch rule.Source {
+		case models.CustomIssueXPath:
+			if htmlNode == nil || htmlNode.Type == html.ErrorNode {
+				return false
+			}
+
+			n, err := htmlquery.Query(htmlNode, rule.Subject)
+			if err != nil {
+				return false
+			}
+
+			if rule.Operator == models.CustomIssueNotExists {
+				return n == nil
+			}
+
+			return n != nil
+
+		case models.CustomIssueField:
+			field, ok := CustomIssueFields[rule.Subject]
+			if !ok {
+				return false
+			}
+
+			return customIssueCondition(rule.Operator, field(pageReport), rule.Value)
+
+		case models.CustomIssueExtraction:
+			for _, e := range pageReport.Extractions {
+				if e.Name == rule.Subject {
+					return customIssueCondition(rule.Operator, e.Value, rule.Value)
+				}
+			}
+
+			return customIssueCondition(rule.Operator, "", rule.Value)
+
+		case models.CustomIssueHeader:
+			return customIssueCondition(rule.Operator, header.Get(rule.Subject), rule.Value)
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: rule.IssueTypeId,
+		Callback:  c,
+	}
+}
+
+// customIssueCondition compares the value with the rule's value using the operator.
+// Strings are compared case-insensitively, and the greater and less operators only
+// match if both values are numbers.
+func customIssueCondition(operator, value, ruleValue string) bool {
+	value = strings.TrimSpace(value)
+	v := strings.ToLower(value)
+	r := strings.ToLower(strings.TrimSpace(ruleValue))
+
+	switch operator {
+	case models.CustomIssueEquals:
+		return v == r
+	case models.CustomIssueNotEquals:
+		return v != r
+	case models.CustomIssueContains:
+		return strings.Contains(v, r)
+	case models.CustomIssueNotContains:
+		return !strings.Contains(v, r)
+	case models.CustomIssueExists:
+		return value != ""
+	case models.CustomIssueNotExists:
+		return value == ""
+	case models.CustomIssueGreater, models.CustomIssueLess:
+		a, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return false
+		}
+
+		b, err := strconv.ParseFloat(r, 64)
+		if err != nil {
+			return false
+		}
+
+		if operator == models.CustomIssueGreater {
+			return a > b
+		}
+
+		return a < b
+	}
+
+	return false
+}
@@ -0,0 +1,248 @@
+package page_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/antchfx/htmlquery"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+const customIssueTypeId = 10000
+
+// Test the CustomIssue reporter with a field rule and a pageReport that doesn't match
+// the condition. The reporter should not report the issue.
+func TestCustomIssueFieldNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Words:      300,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueField,
+		Subject:     "words",
+		Operator:    models.CustomIssueLess,
+		Value:       "250",
+	})
+	if reporter.ErrorType != customIssueTypeId {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestCustomIssueFieldNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CustomIssue reporter with a field rule and a pageReport that matches
+// the condition. The reporter should report the issue.
+func TestCustomIssueFieldIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Title:      "Out of stock - Product",
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueField,
+		Subject:     "title",
+		Operator:    models.CustomIssueContains,
+		Value:       "out of stock",
+	})
+	if reporter.ErrorType != customIssueTypeId {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestCustomIssueFieldIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CustomIssue reporter with a not exists rule and pageReports of an image and
+// an error page, which are not checked. The reporter should not report the issue.
+func TestCustomIssueNotHTMLNoIssues(t *testing.T) {
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueField,
+		Subject:     "title",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	pageReports := []*models.PageReport{
+		{Crawled: true, MediaType: "image/png", StatusCode: 200},
+		{Crawled: true, MediaType: "text/html", StatusCode: 404},
+		{Crawled: false, MediaType: "text/html"},
+	}
+
+	for _, pageReport := range pageReports {
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+		if reportsIssue == true {
+			t.Errorf("TestCustomIssueNotHTMLNoIssues: reportsIssue should be false")
+		}
+	}
+}
+
+// Test the CustomIssue reporter with an extraction rule and a pageReport with an extracted
+// value that doesn't match the condition. The reporter should not report the issue.
+func TestCustomIssueExtractionNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:     true,
+		MediaType:   "text/html",
+		StatusCode:  200,
+		Extractions: []models.Extraction{{Name: "price", Value: "10.00"}},
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueExtraction,
+		Subject:     "price",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestCustomIssueExtractionNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CustomIssue reporter with an extraction rule and a pageReport without the
+// extracted value. The reporter should report the issue.
+func TestCustomIssueExtractionIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueExtraction,
+		Subject:     "price",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestCustomIssueExtractionIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CustomIssue reporter with a header rule and a response that has the header.
+// The reporter should not report the issue.
+func TestCustomIssueHeaderNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueHeader,
+		Subject:     "X-Cache",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	header := &http.Header{}
+	header.Set("X-Cache", "HIT")
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestCustomIssueHeaderNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CustomIssue reporter with a header rule and a response that doesn't have
+// the header. The reporter should report the issue.
+func TestCustomIssueHeaderIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueHeader,
+		Subject:     "X-Cache",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestCustomIssueHeaderIssues: reportsIssue should be true")
+	}
+}
+
+// Test the CustomIssue reporter with an XPath rule and a document where the expression
+// matches. The reporter should not report the issue.
+func TestCustomIssueXPathNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueXPath,
+		Subject:     "//nav[@aria-label='breadcrumb']",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	doc, err := htmlquery.Parse(strings.NewReader(`<html><body><nav aria-label="breadcrumb"></nav></body></html>`))
+	if err != nil {
+		t.Errorf("TestCustomIssueXPathNoIssues: error parsing html")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestCustomIssueXPathNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the CustomIssue reporter with an XPath rule and a document where the expression
+// doesn't match. The reporter should report the issue.
+func TestCustomIssueXPathIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewCustomIssueReporter(models.CustomIssueRule{
+		IssueTypeId: customIssueTypeId,
+		Source:      models.CustomIssueXPath,
+		Subject:     "//nav[@aria-label='breadcrumb']",
+		Operator:    models.CustomIssueNotExists,
+	})
+
+	doc, err := htmlquery.Parse(strings.NewReader(`<html><body><nav></nav></body></html>`))
+	if err != nil {
+		t.Errorf("TestCustomIssueXPathIssues: error parsing html")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestCustomIssueXPathIssues: reportsIssue should be true")
+	}
+}
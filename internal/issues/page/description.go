@@ -39,7 +39,7 @@ func NewEmptyDescriptionReporter() *models.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that checks if a page has a short description.
 // The callback function returns true if the page is text/html, has a status code between 200 and 299,
 // and has a description of less than an specified amount of letters.
-func NewShortDescriptionReporter() *models.PageIssueReporter {
+func NewShortDescriptionReporter(length int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -53,7 +53,7 @@ func NewShortDescriptionReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return len(pageReport.Description) > 0 && len(pageReport.Description) < 80
+		return len(pageReport.Description) > 0 && len(pageReport.Description) < length
 	}
 
 	return &models.PageIssueReporter{
@@ -65,7 +65,7 @@ func NewShortDescriptionReporter() *models.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that checks if a page has a short description.
 // The callback function returns true if the page is text/html, has a status code between 200 and 299,
 // and has a description of more than an specified amount of letters.
-func NewLongDescriptionReporter() *models.PageIssueReporter {
+func NewLongDescriptionReporter(length int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -79,7 +79,7 @@ func NewLongDescriptionReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return len(pageReport.Description) > 160
+		return len(pageReport.Description) > length
 	}
 
 	return &models.PageIssueReporter{
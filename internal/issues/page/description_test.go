@@ -67,7 +67,7 @@ func TestShortDescriptionNoIssues(t *testing.T) {
 			This test should return false if the pageReport description is not short`,
 	}
 
-	reporter := page.NewShortDescriptionReporter()
+	reporter := page.NewShortDescriptionReporter(80)
 	if reporter.ErrorType != errors.ErrorShortDescription {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -89,7 +89,7 @@ func TestShortDescriptionIssues(t *testing.T) {
 		Description: "This test should return true",
 	}
 
-	reporter := page.NewShortDescriptionReporter()
+	reporter := page.NewShortDescriptionReporter(80)
 	if reporter.ErrorType != errors.ErrorShortDescription {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -113,7 +113,7 @@ func TestLongDescriptionNoIssues(t *testing.T) {
 			This test should return false if the pageReport description is not short`,
 	}
 
-	reporter := page.NewLongDescriptionReporter()
+	reporter := page.NewLongDescriptionReporter(160)
 	if reporter.ErrorType != errors.ErrorLongDescription {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -139,7 +139,7 @@ func TestLongDescriptionIssues(t *testing.T) {
 			This test should return false if the pageReport description is not short`,
 	}
 
-	reporter := page.NewLongDescriptionReporter()
+	reporter := page.NewLongDescriptionReporter(160)
 	if reporter.ErrorType != errors.ErrorLongDescription {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
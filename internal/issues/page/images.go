@@ -2,6 +2,7 @@ package page
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/antchfx/htmlquery"
@@ -11,6 +12,19 @@ import (
 	"golang.org/x/net/html"
 )
 
+const (
+	// Images with an intrinsic width or height larger than their width or height
+	// attributes multiplied by this ratio are oversized.
+	MaxImageOversizeRatio = 2
+
+	// Images displayed with an aspect ratio that differs from their intrinsic aspect
+	// ratio more than this fraction are distorted.
+	MaxImageDistortion = 0.05
+)
+
+// Image formats that can be served in a modern format such as WebP or AVIF.
+var legacyImageFormats = []string{"image/jpeg", "image/png", "image/gif", "image/bmp", "image/tiff"}
+
 // Returns a report_manager.PageIssueReporter with a callback function to check
 // if a page has images with no alt attribute. The callback returns true in case
 // the page is text/html and contains images with empty or missing alt attribute.
@@ -41,8 +55,9 @@ func NewAltTextReporter() *models.PageIssueReporter {
 
 // Returns a report_manager.PageIssueReporter with a callback function to check
 // if a page has images with a long alt attribute. The callback returns true in case
-// the page is text/html and contains images with long alt attribute.
-func NewLongAltTextReporter() *models.PageIssueReporter {
+// the page is text/html and contains images with an alt attribute longer than the specified
+// amount of characters.
+func NewLongAltTextReporter(length int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -53,7 +68,7 @@ func NewLongAltTextReporter() *models.PageIssueReporter {
 		}
 
 		for _, i := range pageReport.Images {
-			if len([]rune(i.Alt)) > 100 {
+			if len([]rune(i.Alt)) > length {
 				return true
 			}
 		}
@@ -68,10 +83,10 @@ func NewLongAltTextReporter() *models.PageIssueReporter {
 }
 
 // Returns a report_manager.PageIssueReporter with a callback function to check
-// if the page report is a large image, in wich case it will return true.
-func NewLargeImageReporter() *models.PageIssueReporter {
+// if the page report is an image larger than the specified size in bytes, in wich case it will return true.
+func NewLargeImageReporter(size int64) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
-		return strings.HasPrefix(pageReport.MediaType, "image") && pageReport.Size > 500000
+		return strings.HasPrefix(pageReport.MediaType, "image") && pageReport.Size > size
 	}
 
 	return &models.PageIssueReporter{
@@ -167,3 +182,58 @@ func NewImgWithoutSizeReporter() *models.PageIssueReporter {
 		Callback:  c,
 	}
 }
+
+// Returns a report_manager.PageIssueReporter with a callback function to check
+// if the page report is a JPEG, PNG, GIF, BMP or TIFF image larger than the specified
+// size in bytes, in which case it will return true.
+func NewLegacyImageFormatReporter(size int64) *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		return IsLegacyImageFormat(pageReport.MediaType) && pageReport.Size > size
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorLegacyImageFormat,
+		Callback:  c,
+	}
+}
+
+// IsLegacyImageFormat returns true if the media type is an image format that could be
+// served in a modern format.
+func IsLegacyImageFormat(mediaType string) bool {
+	return slices.Contains(legacyImageFormats, strings.ToLower(mediaType))
+}
+
+// IsOversizedImage returns true if the intrinsic width or height of the image is larger than
+// its width or height attributes multiplied by MaxImageOversizeRatio. The srcset candidates
+// are not checked as they are meant for screens with different pixel densities.
+func IsOversizedImage(i models.Image) bool {
+	if i.Srcset || i.IntrinsicWidth == 0 || i.IntrinsicHeight == 0 {
+		return false
+	}
+
+	return (i.Width > 0 && i.IntrinsicWidth > i.Width*MaxImageOversizeRatio) ||
+		(i.Height > 0 && i.IntrinsicHeight > i.Height*MaxImageOversizeRatio)
+}
+
+// IsDistortedImage returns true if the aspect ratio of the image's width and height attributes
+// differs from its intrinsic aspect ratio more than MaxImageDistortion.
+func IsDistortedImage(i models.Image) bool {
+	if i.Width == 0 || i.Height == 0 || i.IntrinsicWidth == 0 || i.IntrinsicHeight == 0 {
+		return false
+	}
+
+	diff := i.Width*i.IntrinsicHeight - i.Height*i.IntrinsicWidth
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return float64(diff) > MaxImageDistortion*float64(i.Height*i.IntrinsicWidth)
+}
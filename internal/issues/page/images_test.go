@@ -73,7 +73,7 @@ func TestLongAltTextReporterNoIssues(t *testing.T) {
 		Alt: "Image alt text",
 	})
 
-	reporter := page.NewLongAltTextReporter()
+	reporter := page.NewLongAltTextReporter(100)
 	if reporter.ErrorType != errors.ErrorLongAltText {
 		t.Errorf("error type is not correct")
 	}
@@ -98,7 +98,7 @@ func TestLongAltTextReporterIssues(t *testing.T) {
 		Alt: "This is a long alt text. This is a long alt text. This is a long alt text. This is a long alt text. This is a long alt text.",
 	})
 
-	reporter := page.NewLongAltTextReporter()
+	reporter := page.NewLongAltTextReporter(100)
 	if reporter.ErrorType != errors.ErrorLongAltText {
 		t.Errorf("error type is not correct")
 	}
@@ -119,7 +119,7 @@ func TestLargeImageReporterNoIssues(t *testing.T) {
 		Size:      300000,
 	}
 
-	reporter := page.NewLargeImageReporter()
+	reporter := page.NewLargeImageReporter(500000)
 	if reporter.ErrorType != errors.ErrorLargeImage {
 		t.Errorf("error type is not correct")
 	}
@@ -140,7 +140,7 @@ func TestLargeImageReporterIssues(t *testing.T) {
 		Size:      700000,
 	}
 
-	reporter := page.NewLargeImageReporter()
+	reporter := page.NewLargeImageReporter(500000)
 	if reporter.ErrorType != errors.ErrorLargeImage {
 		t.Errorf("error type is not correct")
 	}
@@ -371,3 +371,106 @@ func TestImgWithoutSizeReporterIssues(t *testing.T) {
 		t.Errorf("reportsIssue should be true")
 	}
 }
+
+// Test the LegacyImageFormat reporter with a small JPEG image and a large WebP image.
+// The reporter should not report the issue.
+func TestLegacyImageFormatReporterNoIssues(t *testing.T) {
+	reporter := page.NewLegacyImageFormatReporter(100000)
+	if reporter.ErrorType != errors.ErrorLegacyImageFormat {
+		t.Errorf("error type is not correct")
+	}
+
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		StatusCode: 200,
+		MediaType:  "image/jpeg",
+		Size:       50000,
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("reportsIssue should be false")
+	}
+
+	pageReport.MediaType = "image/webp"
+	pageReport.Size = 300000
+
+	reportsIssue = reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("reportsIssue should be false")
+	}
+}
+
+// Test the LegacyImageFormat reporter with a large PNG image.
+// The reporter should report the issue.
+func TestLegacyImageFormatReporterIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		StatusCode: 200,
+		MediaType:  "image/png",
+		Size:       300000,
+	}
+
+	reporter := page.NewLegacyImageFormatReporter(100000)
+	if reporter.ErrorType != errors.ErrorLegacyImageFormat {
+		t.Errorf("error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("reportsIssue should be true")
+	}
+}
+
+// Test IsOversizedImage with images that are displayed close to their intrinsic size,
+// images without size attributes and srcset candidates.
+func TestIsOversizedImageNoIssues(t *testing.T) {
+	images := []models.Image{
+		{Width: 400, Height: 300, IntrinsicWidth: 800, IntrinsicHeight: 600},
+		{IntrinsicWidth: 2000, IntrinsicHeight: 1500},
+		{Width: 100, Height: 75, IntrinsicWidth: 2000, IntrinsicHeight: 1500, Srcset: true},
+	}
+
+	for _, i := range images {
+		if page.IsOversizedImage(i) {
+			t.Errorf("image %v should not be oversized", i)
+		}
+	}
+}
+
+// Test IsOversizedImage with an image more than twice as large as its size attributes.
+func TestIsOversizedImageIssues(t *testing.T) {
+	i := models.Image{Width: 200, IntrinsicWidth: 1200, IntrinsicHeight: 900}
+
+	if !page.IsOversizedImage(i) {
+		t.Errorf("image %v should be oversized", i)
+	}
+}
+
+// Test IsDistortedImage with size attributes matching the intrinsic aspect ratio
+// and images with missing attributes.
+func TestIsDistortedImageNoIssues(t *testing.T) {
+	images := []models.Image{
+		{Width: 400, Height: 300, IntrinsicWidth: 800, IntrinsicHeight: 600},
+		{Width: 401, Height: 300, IntrinsicWidth: 800, IntrinsicHeight: 600},
+		{Width: 400, IntrinsicWidth: 800, IntrinsicHeight: 200},
+	}
+
+	for _, i := range images {
+		if page.IsDistortedImage(i) {
+			t.Errorf("image %v should not be distorted", i)
+		}
+	}
+}
+
+// Test IsDistortedImage with size attributes that stretch the image.
+func TestIsDistortedImageIssues(t *testing.T) {
+	i := models.Image{Width: 400, Height: 400, IntrinsicWidth: 800, IntrinsicHeight: 600}
+
+	if !page.IsDistortedImage(i) {
+		t.Errorf("image %v should be distorted", i)
+	}
+}
@@ -11,8 +11,8 @@ import (
 
 // Returns a report_manager.PageIssueReporter with a callback function that returns true if
 // the media type is text/html, the status code is between 200 and 299 and the page's html
-// contains too many links.
-func NewTooManyLinksReporter() *models.PageIssueReporter {
+// contains more than the specified number of links.
+func NewTooManyLinksReporter(links int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -26,7 +26,7 @@ func NewTooManyLinksReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return len(pageReport.Links) > 100
+		return len(pageReport.Links) > links
 	}
 
 	return &models.PageIssueReporter{
@@ -25,7 +25,7 @@ func TestTooManyLinksNoIssues(t *testing.T) {
 		pageReport.Links = append(pageReport.Links, models.Link{})
 	}
 
-	reporter := page.NewTooManyLinksReporter()
+	reporter := page.NewTooManyLinksReporter(100)
 	if reporter.ErrorType != errors.ErrorTooManyLinks {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -50,7 +50,7 @@ func TestTooManyLinksIssues(t *testing.T) {
 		pageReport.Links = append(pageReport.Links, models.Link{})
 	}
 
-	reporter := page.NewTooManyLinksReporter()
+	reporter := page.NewTooManyLinksReporter(100)
 	if reporter.ErrorType != errors.ErrorTooManyLinks {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
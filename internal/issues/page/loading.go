@@ -0,0 +1,95 @@
+package page
+
+import (
+	"net/http"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page loads scripts in the head element without the async or defer attributes.
+func NewRenderBlockingScriptReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasLoadingFinding(pageReport, models.LoadingBlockingScript)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorRenderBlockingScript,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page loads more than models.MaxHeadStylesheets stylesheets in the head element.
+func NewHeadStylesheetsReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasLoadingFinding(pageReport, models.LoadingHeadStylesheet)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorTooManyHeadStylesheets,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page preloads resources that are not referenced in the page.
+func NewUnusedPreloadReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasLoadingFinding(pageReport, models.LoadingUnusedPreload)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorUnusedPreload,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the first large content image of the page, which is the largest contentful paint candidate,
+// is lazy loaded.
+func NewLazyLCPImageReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasLoadingFinding(pageReport, models.LoadingLazyLCPImage)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorLazyLCPImage,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page has more than models.LazyLoadMinImages images and the images after them are not lazy loaded.
+func NewImagesWithoutLazyLoadingReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasLoadingFinding(pageReport, models.LoadingEagerImage)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorImagesWithoutLazyLoading,
+		Callback:  c,
+	}
+}
+
+// hasLoadingFinding returns true if the crawled HTML page has a loading finding of the specified type.
+func hasLoadingFinding(pageReport *models.PageReport, t string) bool {
+	if !pageReport.Crawled || pageReport.MediaType != "text/html" {
+		return false
+	}
+
+	if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+		return false
+	}
+
+	for _, f := range pageReport.LoadingFindings {
+		if f.Type == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,252 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the RenderBlockingScript reporter with a page without render-blocking scripts.
+// The reporter should not report the issue.
+func TestRenderBlockingScriptNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/app.js", Type: models.LoadingHeadStylesheet, Element: "script"},
+		},
+	}
+
+	reporter := page.NewRenderBlockingScriptReporter()
+	if reporter.ErrorType != errors.ErrorRenderBlockingScript {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestRenderBlockingScriptNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the RenderBlockingScript reporter with a page loading a synchronous script in the head.
+// The reporter should report the issue.
+func TestRenderBlockingScriptIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/app.js", Type: models.LoadingBlockingScript, Element: "script"},
+		},
+	}
+
+	reporter := page.NewRenderBlockingScriptReporter()
+	if reporter.ErrorType != errors.ErrorRenderBlockingScript {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestRenderBlockingScriptIssues: reportsIssue should be true")
+	}
+}
+
+// Test the HeadStylesheets reporter with a page with few stylesheets in the head.
+// The reporter should not report the issue.
+func TestHeadStylesheetsNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/style.css", Type: models.LoadingBlockingScript, Element: "stylesheet"},
+		},
+	}
+
+	reporter := page.NewHeadStylesheetsReporter()
+	if reporter.ErrorType != errors.ErrorTooManyHeadStylesheets {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestHeadStylesheetsNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the HeadStylesheets reporter with a page with too many stylesheets in the head.
+// The reporter should report the issue.
+func TestHeadStylesheetsIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/style.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		},
+	}
+
+	reporter := page.NewHeadStylesheetsReporter()
+	if reporter.ErrorType != errors.ErrorTooManyHeadStylesheets {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestHeadStylesheetsIssues: reportsIssue should be true")
+	}
+}
+
+// Test the UnusedPreload reporter with a page without unused preloads.
+// The reporter should not report the issue.
+func TestUnusedPreloadNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/unused.js", Type: models.LoadingHeadStylesheet, Element: "preload"},
+		},
+	}
+
+	reporter := page.NewUnusedPreloadReporter()
+	if reporter.ErrorType != errors.ErrorUnusedPreload {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestUnusedPreloadNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the UnusedPreload reporter with a page preloading a script that is not used.
+// The reporter should report the issue.
+func TestUnusedPreloadIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/unused.js", Type: models.LoadingUnusedPreload, Element: "preload"},
+		},
+	}
+
+	reporter := page.NewUnusedPreloadReporter()
+	if reporter.ErrorType != errors.ErrorUnusedPreload {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestUnusedPreloadIssues: reportsIssue should be true")
+	}
+}
+
+// Test the LazyLCPImage reporter with a page with an eager loaded main image.
+// The reporter should not report the issue.
+func TestLazyLCPImageNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/hero.jpg", Type: models.LoadingEagerImage, Element: "img"},
+		},
+	}
+
+	reporter := page.NewLazyLCPImageReporter()
+	if reporter.ErrorType != errors.ErrorLazyLCPImage {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestLazyLCPImageNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the LazyLCPImage reporter with a page with a lazy loaded main image.
+// The reporter should report the issue.
+func TestLazyLCPImageIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/hero.jpg", Type: models.LoadingLazyLCPImage, Element: "img"},
+		},
+	}
+
+	reporter := page.NewLazyLCPImageReporter()
+	if reporter.ErrorType != errors.ErrorLazyLCPImage {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestLazyLCPImageIssues: reportsIssue should be true")
+	}
+}
+
+// Test the ImagesWithoutLazyLoading reporter with a page with lazy loaded images below the fold.
+// The reporter should not report the issue.
+func TestImagesWithoutLazyLoadingNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/footer.jpg", Type: models.LoadingLazyLCPImage, Element: "img"},
+		},
+	}
+
+	reporter := page.NewImagesWithoutLazyLoadingReporter()
+	if reporter.ErrorType != errors.ErrorImagesWithoutLazyLoading {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestImagesWithoutLazyLoadingNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ImagesWithoutLazyLoading reporter with a page with images below the fold without lazy loading.
+// The reporter should report the issue.
+func TestImagesWithoutLazyLoadingIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		LoadingFindings: []models.LoadingFinding{
+			{URL: "https://example.com/footer.jpg", Type: models.LoadingEagerImage, Element: "img"},
+		},
+	}
+
+	reporter := page.NewImagesWithoutLazyLoadingReporter()
+	if reporter.ErrorType != errors.ErrorImagesWithoutLazyLoading {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestImagesWithoutLazyLoadingIssues: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,61 @@
+package page
+
+import (
+	"net/http"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page uses the https scheme, the status code is between 200 and 299 and it loads scripts,
+// stylesheets, iframes or submits forms using the http scheme.
+func NewActiveMixedContentReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasMixedContent(pageReport, models.MixedContentActive)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorActiveMixedContent,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page uses the https scheme, the status code is between 200 and 299 and it loads images,
+// audios, videos or CSS url() resources using the http scheme.
+func NewPassiveMixedContentReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		return hasMixedContent(pageReport, models.MixedContentPassive)
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorPassiveMixedContent,
+		Callback:  c,
+	}
+}
+
+// hasMixedContent returns true if the crawled https page has mixed content of the specified type.
+func hasMixedContent(pageReport *models.PageReport, t string) bool {
+	if !pageReport.Crawled {
+		return false
+	}
+
+	if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+		return false
+	}
+
+	if pageReport.ParsedURL == nil || pageReport.ParsedURL.Scheme != "https" {
+		return false
+	}
+
+	for _, m := range pageReport.MixedContent {
+		if m.Type == t {
+			return true
+		}
+	}
+
+	return false
+}
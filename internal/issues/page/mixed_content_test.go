@@ -0,0 +1,113 @@
+package page_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the ActiveMixedContent reporter with an https page without active mixed content.
+// The reporter should not report the issue.
+func TestActiveMixedContentNoIssues(t *testing.T) {
+	parsedURL, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		ParsedURL:  parsedURL,
+		StatusCode: 200,
+		MixedContent: []models.MixedContent{
+			{URL: "http://example.com/image.jpg", Type: models.MixedContentPassive, Element: "img"},
+		},
+	}
+
+	reporter := page.NewActiveMixedContentReporter()
+	if reporter.ErrorType != errors.ErrorActiveMixedContent {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestActiveMixedContentNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ActiveMixedContent reporter with an https page loading a script over http.
+// The reporter should report the issue.
+func TestActiveMixedContentIssues(t *testing.T) {
+	parsedURL, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		ParsedURL:  parsedURL,
+		StatusCode: 200,
+		MixedContent: []models.MixedContent{
+			{URL: "http://example.com/app.js", Type: models.MixedContentActive, Element: "script"},
+		},
+	}
+
+	reporter := page.NewActiveMixedContentReporter()
+	if reporter.ErrorType != errors.ErrorActiveMixedContent {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestActiveMixedContentIssues: reportsIssue should be true")
+	}
+}
+
+// Test the PassiveMixedContent reporter with an http page. Mixed content only
+// applies to https pages so the reporter should not report the issue.
+func TestPassiveMixedContentNoIssues(t *testing.T) {
+	parsedURL, _ := url.Parse("http://example.com")
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		ParsedURL:  parsedURL,
+		StatusCode: 200,
+		MixedContent: []models.MixedContent{
+			{URL: "http://example.com/image.jpg", Type: models.MixedContentPassive, Element: "img"},
+		},
+	}
+
+	reporter := page.NewPassiveMixedContentReporter()
+	if reporter.ErrorType != errors.ErrorPassiveMixedContent {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestPassiveMixedContentNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the PassiveMixedContent reporter with an https page loading an image over http.
+// The reporter should report the issue.
+func TestPassiveMixedContentIssues(t *testing.T) {
+	parsedURL, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		ParsedURL:  parsedURL,
+		StatusCode: 200,
+		MixedContent: []models.MixedContent{
+			{URL: "http://example.com/image.jpg", Type: models.MixedContentPassive, Element: "img"},
+		},
+	}
+
+	reporter := page.NewPassiveMixedContentReporter()
+	if reporter.ErrorType != errors.ErrorPassiveMixedContent {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestPassiveMixedContentIssues: reportsIssue should be true")
+	}
+}
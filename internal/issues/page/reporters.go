@@ -2,8 +2,31 @@ package page
 
 import "github.com/stjudewashere/seonaut/internal/models"
 
+// DefaultThresholds returns the thresholds used by the issue reporters
+// unless the project has its own thresholds.
+func DefaultThresholds() models.IssueThresholds {
+	return models.IssueThresholds{
+		MinWords:             200,
+		MaxDOMSize:           1500,
+		MinTitleLength:       20,
+		MaxTitleLength:       60,
+		MinDescriptionLength: 80,
+		MaxDescriptionLength: 160,
+		MaxTTFB:              800,
+		MaxImageSize:         500000,
+		MaxAltTextLength:     100,
+		MaxLinks:             100,
+		MinSimilarity:        90,
+		MaxPageWeight:        3145728,
+		MaxScriptsWeight:     1048576,
+		MaxPageRequests:      100,
+		MaxLegacyImageSize:   102400,
+	}
+}
+
 // Returns an slice with all available report_manager.PageIssueReporters.
-func GetAllReporters() []*models.PageIssueReporter {
+// The reporters that depend on a threshold are created using the values in t.
+func GetAllReporters(t models.IssueThresholds) []*models.PageIssueReporter {
 	return []*models.PageIssueReporter{
 		// Add status code issue reporters
 		NewStatus30xReporter(),
@@ -12,14 +35,14 @@ func GetAllReporters() []*models.PageIssueReporter {
 
 		// Add title issue reporters
 		NewEmptyTitleReporter(),
-		NewShortTitleReporter(),
-		NewLongTitleReporter(),
+		NewShortTitleReporter(t.MinTitleLength),
+		NewLongTitleReporter(t.MaxTitleLength),
 		NewMultipleTitleTagsReporter(),
 
 		// Add description issue reporters
 		NewEmptyDescriptionReporter(),
-		NewShortDescriptionReporter(),
-		NewLongDescriptionReporter(),
+		NewShortDescriptionReporter(t.MinDescriptionLength),
+		NewLongDescriptionReporter(t.MaxDescriptionLength),
 		NewMultipleDescriptionTagsReporter(),
 
 		// Add indexability issue reporters
@@ -36,7 +59,7 @@ func GetAllReporters() []*models.PageIssueReporter {
 		NewMetasInBodyReporter(),
 
 		// Add link issue reporters
-		NewTooManyLinksReporter(),
+		NewTooManyLinksReporter(t.MaxLinks),
 		NewInternalNoFollowLinksReporter(),
 		NewExternalLinkWitoutNoFollowReporter(),
 		NewHTTPLinksReporter(),
@@ -44,11 +67,14 @@ func GetAllReporters() []*models.PageIssueReporter {
 		NewExternalLinkRedirectReporter(),
 		NewExternalLinkBrokenReporter(),
 		NewLocalhostLinksReporter(),
+		NewEmptyAnchorTextReporter(),
+		NewImageLinkWithoutAltReporter(),
 
 		// Add image issue reporters
 		NewAltTextReporter(),
-		NewLongAltTextReporter(),
-		NewLargeImageReporter(),
+		NewLongAltTextReporter(t.MaxAltTextLength),
+		NewLargeImageReporter(t.MaxImageSize),
+		NewLegacyImageFormatReporter(t.MaxLegacyImageSize),
 		NewNoImageIndexReporter(),
 		NewMissingImgTagInPictureReporter(),
 		NewImgWithoutSizeReporter(),
@@ -66,19 +92,30 @@ func GetAllReporters() []*models.PageIssueReporter {
 		NewValidHeadingsOrderReporter(),
 
 		// Add content issue reporters
-		NewLittleContentReporter(),
+		NewLittleContentReporter(t.MinWords),
 		NewIncorrectMediaTypeReporter(),
 		NewDuplicatedIdReporter(),
-		NewDOMSizeReporter(1500), // report html documents with more than 1500 nodes
+		NewDOMSizeReporter(t.MaxDOMSize),
 		NewPaginationReporter(),
 
 		// Add scheme issue reporters
 		NewHTTPSchemeReporter(),
+		NewActiveMixedContentReporter(),
+		NewPassiveMixedContentReporter(),
 
 		// Add security issue reporters
 		NewMissingHSTSHeaderReporter(),
 		NewMissingCSPReporter(),
 		NewMissingContentTypeOptionsReporter(),
+		NewWeakHSTSReporter(),
+		NewInvalidCSPReporter(),
+		NewUnsafeCSPReporter(),
+		NewReferrerPolicyReporter(),
+		NewMissingPermissionsPolicyReporter(),
+		NewMissingFramingProtectionReporter(),
+		NewCookieWithoutSecureReporter(),
+		NewCookieWithoutHttpOnlyReporter(),
+		NewCookieWithoutSameSiteReporter(),
 
 		// Add timeout issue reporter
 		NewTimeoutReporter(),
@@ -89,7 +126,20 @@ func GetAllReporters() []*models.PageIssueReporter {
 		NewMultipleSlashesReporter(),
 
 		// Add Time To Firts Byte reporter
-		NewSlowTTFBReporter(),
+		NewSlowTTFBReporter(t.MaxTTFB),
+
+		// Add caching and compression issue reporters
+		NewStaticCacheControlReporter(),
+		NewHTMLNoStoreReporter(),
+		NewMissingValidatorsReporter(),
+		NewUncompressedTextReporter(),
+
+		// Add page loading issue reporters
+		NewRenderBlockingScriptReporter(),
+		NewHeadStylesheetsReporter(),
+		NewUnusedPreloadReporter(),
+		NewLazyLCPImageReporter(),
+		NewImagesWithoutLazyLoadingReporter(),
 
 		// Add form reporters
 		NewFormOnHTTPReporter(),
@@ -97,5 +147,24 @@ func GetAllReporters() []*models.PageIssueReporter {
 
 		// Add Viewport issue report
 		NewViewportTagReporter(),
+
+		// Add structured data issue reporters
+		NewInvalidStructuredDataReporter(),
+		NewIncompleteStructuredDataReporter(),
+
+		// Add social tags issue reporters
+		NewMissingOpenGraphReporter(),
+		NewRelativeOpenGraphImageReporter(),
+		NewOpenGraphURLMismatchReporter(),
+
+		// Add accessibility issue reporters
+		NewInputWithoutLabelReporter(),
+		NewButtonWithoutNameReporter(),
+		NewLinkWithoutNameReporter(),
+		NewInvalidAriaLabelledByReporter(),
+		NewPositiveTabindexReporter(),
+		NewIframeWithoutTitleReporter(),
+		NewTableWithoutHeadersReporter(),
+		NewUserScalableDisabledReporter(),
 	}
 }
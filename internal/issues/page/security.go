@@ -2,6 +2,7 @@ package page
 
 import (
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -86,3 +87,395 @@ func NewMissingContentTypeOptionsReporter() *models.PageIssueReporter {
 		Callback:  c,
 	}
 }
+
+// HSTS policies with a max-age lower than one year are too short to protect returning visitors.
+const MinHSTSMaxAge = 365 * 24 * 60 * 60
+
+// cspDirectives contains the valid Content-Security-Policy directive names. The directives
+// set to true have a source list as their value.
+var cspDirectives = map[string]bool{
+	"default-src":               true,
+	"script-src":                true,
+	"script-src-elem":           true,
+	"script-src-attr":           true,
+	"style-src":                 true,
+	"style-src-elem":            true,
+	"style-src-attr":            true,
+	"img-src":                   true,
+	"font-src":                  true,
+	"connect-src":               true,
+	"media-src":                 true,
+	"object-src":                true,
+	"frame-src":                 true,
+	"child-src":                 true,
+	"worker-src":                true,
+	"manifest-src":              true,
+	"prefetch-src":              true,
+	"fenced-frame-src":          true,
+	"base-uri":                  true,
+	"form-action":               true,
+	"frame-ancestors":           true,
+	"sandbox":                   false,
+	"report-uri":                false,
+	"report-to":                 false,
+	"upgrade-insecure-requests": false,
+	"block-all-mixed-content":   false,
+	"require-trusted-types-for": false,
+	"trusted-types":             false,
+}
+
+// cspKeywords contains the valid quoted keywords in a CSP source list.
+var cspKeywords = []string{
+	"'self'",
+	"'none'",
+	"'unsafe-inline'",
+	"'unsafe-eval'",
+	"'unsafe-hashes'",
+	"'strict-dynamic'",
+	"'report-sample'",
+	"'wasm-unsafe-eval'",
+	"'inline-speculation-rules'",
+}
+
+// referrerPolicies contains the valid Referrer-Policy values.
+var referrerPolicies = []string{
+	"no-referrer",
+	"no-referrer-when-downgrade",
+	"origin",
+	"origin-when-cross-origin",
+	"same-origin",
+	"strict-origin",
+	"strict-origin-when-cross-origin",
+	"unsafe-url",
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and any of the page's content
+// security policies, in the HTTP headers or in meta tags, is not valid. A policy is not valid if it
+// has unknown or duplicated directives, unquoted keywords such as self, unknown quoted keywords, or
+// 'none' combined with other sources.
+func NewInvalidCSPReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, policy := range contentSecurityPolicies(htmlNode, header) {
+			if _, ok := parseCSP(policy); !ok {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorInvalidCSP,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and any of the page's content
+// security policies allows scripts it should block. The script sources, from script-src or else
+// default-src, are unsafe if they contain 'unsafe-inline' without a nonce, a hash or 'strict-dynamic',
+// or a wildcard source such as *, http:, https: or data:. The object-src sources are unsafe if they
+// contain a wildcard source.
+func NewUnsafeCSPReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		for _, policy := range contentSecurityPolicies(htmlNode, header) {
+			directives, _ := parseCSP(policy)
+
+			scripts := cspSources(directives, "script-src")
+			if hasWildcardSource(scripts) || allowsUnsafeInline(scripts) {
+				return true
+			}
+
+			if hasWildcardSource(cspSources(directives, "object-src")) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorUnsafeCSP,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page doesn't have a
+// valid referrer policy in the Referrer-Policy header or in a referrer meta tag, or its policy is
+// unsafe-url, which sends the full URL to other sites even from HTTPS to HTTP.
+func NewReferrerPolicyReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		values := []string{header.Get("Referrer-Policy")}
+		if htmlNode.Type != html.ErrorNode {
+			for _, n := range htmlquery.Find(htmlNode, "//meta[@name]") {
+				if strings.EqualFold(htmlquery.SelectAttr(n, "name"), "referrer") {
+					values = append(values, htmlquery.SelectAttr(n, "content"))
+				}
+			}
+		}
+
+		// When there are several policies the last valid one is used.
+		policy := ""
+		for _, v := range values {
+			for _, p := range strings.Split(v, ",") {
+				p = strings.ToLower(strings.TrimSpace(p))
+				if slices.Contains(referrerPolicies, p) {
+					policy = p
+				}
+			}
+		}
+
+		return policy == "" || policy == "unsafe-url"
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorReferrerPolicy,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page has neither
+// a Permissions-Policy header nor the older Feature-Policy header.
+func NewMissingPermissionsPolicyReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		return header.Get("Permissions-Policy") == "" && header.Get("Feature-Policy") == ""
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorMissingPermissionsPolicy,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page can be framed
+// by other sites, because it has neither an X-Frame-Options header with DENY or SAMEORIGIN nor
+// a frame-ancestors directive in the Content-Security-Policy header. The frame-ancestors
+// directive is ignored in meta tags.
+func NewMissingFramingProtectionReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		frameOptions := strings.ToUpper(strings.TrimSpace(header.Get("X-Frame-Options")))
+		if frameOptions == "DENY" || frameOptions == "SAMEORIGIN" {
+			return false
+		}
+
+		for _, policy := range headerPolicies(header) {
+			directives, _ := parseCSP(policy)
+			if _, ok := directives["frame-ancestors"]; ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorMissingFramingProtection,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page was crawled using HTTPS and it has a Strict-Transport-Security header with a max-age
+// lower than MinHSTSMaxAge or without the includeSubDomains directive. Pages without the header
+// are reported by the MissingHSTSHeader reporter.
+func NewWeakHSTSReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled || pageReport.ParsedURL == nil || pageReport.ParsedURL.Scheme != "https" {
+			return false
+		}
+
+		hstsHeader := header.Get("Strict-Transport-Security")
+		if hstsHeader == "" {
+			return false
+		}
+
+		maxAge := 0
+		includeSubDomains := false
+		for _, directive := range strings.Split(hstsHeader, ";") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "max-age":
+				maxAge, _ = strconv.Atoi(strings.Trim(strings.TrimSpace(value), `"`))
+			case "includesubdomains":
+				includeSubDomains = true
+			}
+		}
+
+		return maxAge < MinHSTSMaxAge || !includeSubDomains
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorWeakHSTS,
+		Callback:  c,
+	}
+}
+
+// contentSecurityPolicies returns the page's content security policies from the
+// Content-Security-Policy headers and meta tags. The meta tags are ignored if the html
+// couldn't be parsed.
+func contentSecurityPolicies(htmlNode *html.Node, header *http.Header) []string {
+	policies := headerPolicies(header)
+	if htmlNode.Type == html.ErrorNode {
+		return policies
+	}
+
+	for _, n := range htmlquery.Find(htmlNode, "//meta[@http-equiv]") {
+		if strings.EqualFold(htmlquery.SelectAttr(n, "http-equiv"), "Content-Security-Policy") {
+			policies = append(policies, htmlquery.SelectAttr(n, "content"))
+		}
+	}
+
+	return policies
+}
+
+// headerPolicies returns the content security policies in the Content-Security-Policy headers.
+// A header can contain several policies separated by commas.
+func headerPolicies(header *http.Header) []string {
+	policies := []string{}
+	for _, v := range header.Values("Content-Security-Policy") {
+		policies = append(policies, strings.Split(v, ",")...)
+	}
+
+	return policies
+}
+
+// parseCSP parses a content security policy and returns its directives mapped to their values.
+// It also returns false if the policy is not valid, in which case the directives are parsed
+// ignoring the errors.
+func parseCSP(policy string) (map[string][]string, bool) {
+	directives := make(map[string][]string)
+	valid := true
+
+	for _, d := range strings.Split(policy, ";") {
+		tokens := strings.Fields(d)
+		if len(tokens) == 0 {
+			continue
+		}
+
+		name := strings.ToLower(tokens[0])
+		sourceList, ok := cspDirectives[name]
+		if !ok {
+			valid = false
+			continue
+		}
+
+		// Browsers ignore the duplicated directives.
+		if _, ok := directives[name]; ok {
+			valid = false
+			continue
+		}
+
+		directives[name] = tokens[1:]
+		if sourceList && !validCSPSources(tokens[1:]) {
+			valid = false
+		}
+	}
+
+	if len(directives) == 0 {
+		valid = false
+	}
+
+	return directives, valid
+}
+
+// validCSPSources returns false if the source list contains unquoted keywords, unknown quoted
+// keywords or 'none' combined with other sources.
+func validCSPSources(sources []string) bool {
+	for _, s := range sources {
+		s = strings.ToLower(s)
+		if strings.HasPrefix(s, "'") {
+			if slices.Contains(cspKeywords, s) {
+				continue
+			}
+
+			if strings.HasSuffix(s, "'") && len(s) > 2 && isNonceOrHash(s) {
+				continue
+			}
+
+			return false
+		}
+
+		if slices.Contains(cspKeywords, "'"+s+"'") {
+			return false
+		}
+	}
+
+	none := slices.ContainsFunc(sources, func(s string) bool { return strings.EqualFold(s, "'none'") })
+
+	return !none || len(sources) == 1
+}
+
+// cspSources returns the sources of a fetch directive, using the default-src sources if the
+// directive is not in the policy.
+func cspSources(directives map[string][]string, name string) []string {
+	if sources, ok := directives[name]; ok {
+		return sources
+	}
+
+	return directives["default-src"]
+}
+
+// hasWildcardSource returns true if the source list allows any host or any host using a scheme.
+func hasWildcardSource(sources []string) bool {
+	for _, s := range sources {
+		switch strings.ToLower(s) {
+		case "*", "http:", "https:", "data:":
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowsUnsafeInline returns true if the source list contains 'unsafe-inline' without a nonce,
+// a hash or 'strict-dynamic', in which case browsers ignore 'unsafe-inline'.
+func allowsUnsafeInline(sources []string) bool {
+	unsafeInline := false
+	for _, s := range sources {
+		s = strings.ToLower(s)
+		if s == "'unsafe-inline'" {
+			unsafeInline = true
+		}
+
+		if s == "'strict-dynamic'" || isNonceOrHash(s) {
+			return false
+		}
+	}
+
+	return unsafeInline
+}
+
+// isNonceOrHash returns true if the source is a quoted nonce or hash source.
+func isNonceOrHash(s string) bool {
+	for _, prefix := range []string{"'nonce-", "'sha256-", "'sha384-", "'sha512-"} {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
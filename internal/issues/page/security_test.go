@@ -2,6 +2,7 @@ package page_test
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -200,3 +201,325 @@ func TestMissingContentTypeOptionsIssues(t *testing.T) {
 		t.Errorf("reportsIssue should be true")
 	}
 }
+
+// Test the InvalidCSP reporter with a valid policy in the header and a meta tag.
+// The reporter should not report the issue.
+func TestInvalidCSPNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Content-Security-Policy", "default-src 'self'; script-src 'self' 'nonce-r4nd0m' https://cdn.example.com; object-src 'none'; upgrade-insecure-requests")
+
+	source := `<html><head><meta http-equiv="content-security-policy" content="img-src * data:"></head></html>`
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewInvalidCSPReporter()
+	if reporter.ErrorType != errors.ErrorInvalidCSP {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestInvalidCSPNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidCSP reporter with policies with unquoted keywords, unknown and duplicated
+// directives and 'none' combined with other sources. The reporter should report the issue.
+func TestInvalidCSPIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewInvalidCSPReporter()
+	if reporter.ErrorType != errors.ErrorInvalidCSP {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	policies := []string{
+		"default-src self",
+		"script-scr 'self'",
+		"script-src 'self'; script-src https://cdn.example.com",
+		"object-src 'none' https://example.com",
+		"script-src 'unsafe-everything'",
+	}
+
+	for _, policy := range policies {
+		header := &http.Header{}
+		header.Set("Content-Security-Policy", policy)
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == false {
+			t.Errorf("TestInvalidCSPIssues: reportsIssue should be true with policy %q", policy)
+		}
+	}
+}
+
+// Test the UnsafeCSP reporter with policies that use nonces, strict-dynamic or list their
+// sources. The reporter should not report the issue.
+func TestUnsafeCSPNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewUnsafeCSPReporter()
+	if reporter.ErrorType != errors.ErrorUnsafeCSP {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	policies := []string{
+		"default-src 'self'; img-src *",
+		"script-src 'unsafe-inline' 'nonce-r4nd0m'; object-src 'none'",
+		"script-src 'strict-dynamic' 'unsafe-inline' 'sha256-abc='",
+	}
+
+	for _, policy := range policies {
+		header := &http.Header{}
+		header.Set("Content-Security-Policy", policy)
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == true {
+			t.Errorf("TestUnsafeCSPNoIssues: reportsIssue should be false with policy %q", policy)
+		}
+	}
+}
+
+// Test the UnsafeCSP reporter with policies allowing inline scripts or scripts from any source.
+// The reporter should report the issue.
+func TestUnsafeCSPIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewUnsafeCSPReporter()
+	if reporter.ErrorType != errors.ErrorUnsafeCSP {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	policies := []string{
+		"default-src 'self' 'unsafe-inline'",
+		"default-src 'self'; script-src https:",
+		"script-src 'self'; object-src *",
+	}
+
+	for _, policy := range policies {
+		header := &http.Header{}
+		header.Set("Content-Security-Policy", policy)
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == false {
+			t.Errorf("TestUnsafeCSPIssues: reportsIssue should be true with policy %q", policy)
+		}
+	}
+}
+
+// Test the ReferrerPolicy reporter with a page with a valid referrer meta tag.
+// The reporter should not report the issue.
+func TestReferrerPolicyNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	source := `<html><head><meta name="referrer" content="strict-origin-when-cross-origin"></head></html>`
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		t.Errorf("error parsing html source")
+	}
+
+	reporter := page.NewReferrerPolicyReporter()
+	if reporter.ErrorType != errors.ErrorReferrerPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, doc, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestReferrerPolicyNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the ReferrerPolicy reporter with a page with an unsafe-url policy.
+// The reporter should report the issue.
+func TestReferrerPolicyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Referrer-Policy", "no-referrer, unsafe-url")
+
+	reporter := page.NewReferrerPolicyReporter()
+	if reporter.ErrorType != errors.ErrorReferrerPolicy {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == false {
+		t.Errorf("TestReferrerPolicyIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingPermissionsPolicy reporter with a page with a Permissions-Policy header.
+// The reporter should not report the issue.
+func TestMissingPermissionsPolicyNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Permissions-Policy", "camera=(), geolocation=()")
+
+	reporter := page.NewMissingPermissionsPolicyReporter()
+	if reporter.ErrorType != errors.ErrorMissingPermissionsPolicy {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestMissingPermissionsPolicyNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingPermissionsPolicy reporter with a page without a Permissions-Policy header.
+// The reporter should report the issue.
+func TestMissingPermissionsPolicyIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	reporter := page.NewMissingPermissionsPolicyReporter()
+	if reporter.ErrorType != errors.ErrorMissingPermissionsPolicy {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestMissingPermissionsPolicyIssues: reportsIssue should be true")
+	}
+}
+
+// Test the MissingFramingProtection reporter with a page with a frame-ancestors directive.
+// The reporter should not report the issue.
+func TestMissingFramingProtectionNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("Content-Security-Policy", "default-src 'self'; frame-ancestors 'none'")
+
+	reporter := page.NewMissingFramingProtectionReporter()
+	if reporter.ErrorType != errors.ErrorMissingFramingProtection {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestMissingFramingProtectionNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingFramingProtection reporter with a page with an invalid X-Frame-Options header.
+// The reporter should report the issue.
+func TestMissingFramingProtectionIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+	}
+
+	header := &http.Header{}
+	header.Set("X-Frame-Options", "ALLOW-FROM https://example.com")
+
+	reporter := page.NewMissingFramingProtectionReporter()
+	if reporter.ErrorType != errors.ErrorMissingFramingProtection {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == false {
+		t.Errorf("TestMissingFramingProtectionIssues: reportsIssue should be true")
+	}
+}
+
+// Test the WeakHSTS reporter with a one year HSTS policy including subdomains.
+// The reporter should not report the issue.
+func TestWeakHSTSNoIssues(t *testing.T) {
+	u, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		ParsedURL: u,
+	}
+
+	header := &http.Header{}
+	header.Set("Strict-Transport-Security", "max-age=63072000; includeSubDomains; preload")
+
+	reporter := page.NewWeakHSTSReporter()
+	if reporter.ErrorType != errors.ErrorWeakHSTS {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+	if reportsIssue == true {
+		t.Errorf("TestWeakHSTSNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the WeakHSTS reporter with a short max-age and without includeSubDomains.
+// The reporter should report the issue.
+func TestWeakHSTSIssues(t *testing.T) {
+	u, _ := url.Parse("https://example.com")
+	pageReport := &models.PageReport{
+		Crawled:   true,
+		ParsedURL: u,
+	}
+
+	reporter := page.NewWeakHSTSReporter()
+	if reporter.ErrorType != errors.ErrorWeakHSTS {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	for _, v := range []string{"max-age=3600; includeSubDomains", "max-age=31536000"} {
+		header := &http.Header{}
+		header.Set("Strict-Transport-Security", v)
+
+		reportsIssue := reporter.Callback(pageReport, &html.Node{}, header)
+
+		if reportsIssue == false {
+			t.Errorf("TestWeakHSTSIssues: reportsIssue should be true with %q", v)
+		}
+	}
+}
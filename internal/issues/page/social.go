@@ -0,0 +1,122 @@
+package page
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page is missing
+// the og:title or the og:image meta tags.
+func NewMissingOpenGraphReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		return socialTag(pageReport, "og:title") == "" || socialTag(pageReport, "og:image") == ""
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorMissingOpenGraph,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the og:image meta
+// tag contains a relative URL.
+func NewRelativeOpenGraphImageReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		image := socialTag(pageReport, "og:image")
+		if image == "" {
+			return false
+		}
+
+		parsedURL, err := url.Parse(image)
+		if err != nil {
+			return false
+		}
+
+		return !parsedURL.IsAbs()
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorRelativeOpenGraphImage,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the og:url meta
+// tag doesn't match the page's canonical URL. If the page has no canonical the og:url is
+// compared with the page URL.
+func NewOpenGraphURLMismatchReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		if pageReport.MediaType != "text/html" {
+			return false
+		}
+
+		if pageReport.StatusCode < 200 || pageReport.StatusCode >= 300 {
+			return false
+		}
+
+		ogURL := socialTag(pageReport, "og:url")
+		if ogURL == "" {
+			return false
+		}
+
+		canonical := pageReport.Canonical
+		if canonical == "" {
+			canonical = pageReport.URL
+		}
+
+		return ogURL != canonical
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorOpenGraphURLMismatch,
+		Callback:  c,
+	}
+}
+
+// socialTag returns the content of the first social tag with the specified property
+// or an empty string if the page doesn't have it.
+func socialTag(pageReport *models.PageReport, property string) string {
+	for _, t := range pageReport.SocialTags {
+		if t.Property == property {
+			return t.Content
+		}
+	}
+
+	return ""
+}
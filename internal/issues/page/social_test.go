@@ -0,0 +1,161 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the MissingOpenGraph reporter with a pageReport that has og:title and og:image tags.
+// The reporter should not report the issue.
+func TestMissingOpenGraphNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: []models.SocialTag{
+			{Property: "og:title", Content: "Title"},
+			{Property: "og:image", Content: "https://example.com/image.jpg"},
+		},
+	}
+
+	reporter := page.NewMissingOpenGraphReporter()
+	if reporter.ErrorType != errors.ErrorMissingOpenGraph {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestMissingOpenGraphNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the MissingOpenGraph reporter with a pageReport that has no og:image tag.
+// The reporter should report the issue.
+func TestMissingOpenGraphIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: []models.SocialTag{
+			{Property: "og:title", Content: "Title"},
+		},
+	}
+
+	reporter := page.NewMissingOpenGraphReporter()
+	if reporter.ErrorType != errors.ErrorMissingOpenGraph {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestMissingOpenGraphIssues: reportsIssue should be true")
+	}
+}
+
+// Test the RelativeOpenGraphImage reporter with a pageReport that has an absolute og:image URL.
+// The reporter should not report the issue.
+func TestRelativeOpenGraphImageNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: []models.SocialTag{
+			{Property: "og:image", Content: "https://example.com/image.jpg"},
+		},
+	}
+
+	reporter := page.NewRelativeOpenGraphImageReporter()
+	if reporter.ErrorType != errors.ErrorRelativeOpenGraphImage {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestRelativeOpenGraphImageNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the RelativeOpenGraphImage reporter with a pageReport that has a relative og:image URL.
+// The reporter should report the issue.
+func TestRelativeOpenGraphImageIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		SocialTags: []models.SocialTag{
+			{Property: "og:image", Content: "/image.jpg"},
+		},
+	}
+
+	reporter := page.NewRelativeOpenGraphImageReporter()
+	if reporter.ErrorType != errors.ErrorRelativeOpenGraphImage {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestRelativeOpenGraphImageIssues: reportsIssue should be true")
+	}
+}
+
+// Test the OpenGraphURLMismatch reporter with a pageReport which og:url matches the canonical.
+// The reporter should not report the issue.
+func TestOpenGraphURLMismatchNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page?utm_source=test",
+		Canonical:  "https://example.com/page",
+		SocialTags: []models.SocialTag{
+			{Property: "og:url", Content: "https://example.com/page"},
+		},
+	}
+
+	reporter := page.NewOpenGraphURLMismatchReporter()
+	if reporter.ErrorType != errors.ErrorOpenGraphURLMismatch {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestOpenGraphURLMismatchNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the OpenGraphURLMismatch reporter with a pageReport which og:url doesn't match the canonical.
+// The reporter should report the issue.
+func TestOpenGraphURLMismatchIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		URL:        "https://example.com/page",
+		Canonical:  "https://example.com/page",
+		SocialTags: []models.SocialTag{
+			{Property: "og:url", Content: "https://example.com/other-page"},
+		},
+	}
+
+	reporter := page.NewOpenGraphURLMismatchReporter()
+	if reporter.ErrorType != errors.ErrorOpenGraphURLMismatch {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestOpenGraphURLMismatchIssues: reportsIssue should be true")
+	}
+}
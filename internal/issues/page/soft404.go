@@ -0,0 +1,93 @@
+package page
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/simhash"
+
+	"golang.org/x/net/html"
+)
+
+// Pages with less words in the main content have very little content, one of the signals of a soft 404.
+const Soft404MaxWords = 50
+
+// DefaultSoft404Patterns contains the texts used in the title or H1 of not found pages for each
+// language. Projects can add their own patterns to these ones.
+var DefaultSoft404Patterns = map[string][]string{
+	"en": {"not found", "page not found", "404", "no results", "nothing found", "no longer available", "does not exist", "doesn't exist", "page unavailable"},
+	"es": {"no encontrada", "no encontrado", "no existe", "sin resultados", "no se encontraron resultados", "ya no está disponible"},
+	"fr": {"introuvable", "page non trouvée", "aucun résultat", "n'existe pas", "n'existe plus"},
+	"de": {"nicht gefunden", "keine ergebnisse", "existiert nicht", "nicht mehr verfügbar"},
+	"it": {"non trovata", "non trovato", "nessun risultato", "non esiste"},
+	"pt": {"não encontrada", "não encontrado", "nenhum resultado", "não existe"},
+	"nl": {"niet gevonden", "geen resultaten", "bestaat niet"},
+	"ca": {"no s'ha trobat", "no trobada", "cap resultat", "no existeix"},
+}
+
+// Soft404Patterns returns the default soft 404 patterns with the project's patterns added to them.
+// The patterns are normalized so they can be compared with the pages' title and H1.
+func Soft404Patterns(custom []models.Phrase) map[string][]string {
+	return phrasesByLang(DefaultSoft404Patterns, custom)
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the media type is text/html, the status code is between 200 and 299 and the page looks like
+// a not found page. Three signals are checked and the page is reported if at least two of them
+// are present: the title or H1 contain one of the soft 404 patterns in the page's language,
+// the main content has less than Soft404MaxWords words, and the main content is similar to the
+// response of a URL that doesn't exist in the site. If the page's language doesn't have soft 404
+// patterns the patterns of all the languages are used.
+func NewSoft404Reporter(patterns map[string][]string, notFound models.NotFoundReference, minSimilarity int) *models.PageIssueReporter {
+	all := []string{}
+	for _, texts := range patterns {
+		all = append(all, texts...)
+	}
+
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !isCrawledHTML(pageReport) {
+			return false
+		}
+
+		texts, ok := patterns[PrimaryLang(pageReport.Lang)]
+		if !ok {
+			texts = all
+		}
+
+		signals := 0
+		if containsPattern(pageReport.Title, texts) || containsPattern(pageReport.H1, texts) {
+			signals++
+		}
+
+		if pageReport.MainWords < Soft404MaxWords {
+			signals++
+		}
+
+		if notFound.MainWords > 0 && pageReport.MainWords > 0 {
+			if simhash.Similarity(pageReport.SimHash, notFound.SimHash) >= minSimilarity {
+				signals++
+			}
+		}
+
+		return signals >= 2
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorSoft404,
+		Callback:  c,
+	}
+}
+
+// containsPattern returns true if the normalized text contains any of the patterns as whole words.
+func containsPattern(s string, patterns []string) bool {
+	text := " " + normalizeAnchorText(s) + " "
+	for _, p := range patterns {
+		if p != "" && strings.Contains(text, " "+p+" ") {
+			return true
+		}
+	}
+
+	return false
+}
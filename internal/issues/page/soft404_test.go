@@ -0,0 +1,108 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the Soft404 reporter with a page that has enough content and a title that doesn't
+// look like a not found page. The reporter should not report the issue.
+func TestSoft404NoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "en",
+		Title:      "Found the best shoes",
+		H1:         "Shoes",
+		MainWords:  300,
+		SimHash:    0xffffffff00000000,
+	}
+
+	notFound := models.NotFoundReference{SimHash: 0x00000000ffffffff, MainWords: 20}
+	reporter := page.NewSoft404Reporter(page.Soft404Patterns(nil), notFound, 90)
+	if reporter.ErrorType != errors.ErrorSoft404 {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestSoft404NoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the Soft404 reporter with a page that has a not found title and very little content.
+// The reporter should report the issue.
+func TestSoft404PatternIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "en-US",
+		Title:      "Oops! Page not found.",
+		MainWords:  12,
+	}
+
+	reporter := page.NewSoft404Reporter(page.Soft404Patterns(nil), models.NotFoundReference{}, 90)
+	if reporter.ErrorType != errors.ErrorSoft404 {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestSoft404PatternIssues: reportsIssue should be true")
+	}
+}
+
+// Test the Soft404 reporter with a page in a language with custom patterns.
+// The reporter should report the issue.
+func TestSoft404CustomPatternIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "ast",
+		H1:         "Páxina nun alcontrada",
+		MainWords:  8,
+	}
+
+	custom := []models.Phrase{{Lang: "ast", Text: "nun alcontrada"}}
+	reporter := page.NewSoft404Reporter(page.Soft404Patterns(custom), models.NotFoundReference{}, 90)
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestSoft404CustomPatternIssues: reportsIssue should be true")
+	}
+}
+
+// Test the Soft404 reporter with a page with little content similar to the not found reference.
+// The reporter should report the issue.
+func TestSoft404SimilarityIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled:    true,
+		MediaType:  "text/html",
+		StatusCode: 200,
+		Lang:       "en",
+		Title:      "Shoes",
+		MainWords:  30,
+		SimHash:    0xffffffff00000001,
+	}
+
+	notFound := models.NotFoundReference{SimHash: 0xffffffff00000000, MainWords: 30}
+	reporter := page.NewSoft404Reporter(page.Soft404Patterns(nil), notFound, 90)
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestSoft404SimilarityIssues: reportsIssue should be true")
+	}
+}
@@ -0,0 +1,56 @@
+package page
+
+import (
+	"net/http"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page contains JSON-LD structured data that could not be parsed because of invalid JSON.
+func NewInvalidStructuredDataReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		for _, sd := range pageReport.StructuredData {
+			if sd.Error != "" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorInvalidStructuredData,
+		Callback:  c,
+	}
+}
+
+// Returns a report_manager.PageIssueReporter with a callback function that returns true if
+// the page contains structured data entities missing any of the required properties of their type.
+func NewIncompleteStructuredDataReporter() *models.PageIssueReporter {
+	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+		if !pageReport.Crawled {
+			return false
+		}
+
+		for _, sd := range pageReport.StructuredData {
+			if len(sd.Missing) > 0 {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return &models.PageIssueReporter{
+		ErrorType: errors.ErrorIncompleteStructuredData,
+		Callback:  c,
+	}
+}
@@ -0,0 +1,100 @@
+package page_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/issues/errors"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"golang.org/x/net/html"
+)
+
+// Test the InvalidStructuredData reporter with a pageReport containing valid structured data.
+// The reporter should not report the issue.
+func TestInvalidStructuredDataNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+		StructuredData: []models.StructuredData{
+			{Format: models.StructuredDataJSONLD, Type: "Organization"},
+		},
+	}
+
+	reporter := page.NewInvalidStructuredDataReporter()
+	if reporter.ErrorType != errors.ErrorInvalidStructuredData {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestInvalidStructuredDataNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the InvalidStructuredData reporter with a pageReport containing a JSON-LD parsing error.
+// The reporter should report the issue.
+func TestInvalidStructuredDataIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+		StructuredData: []models.StructuredData{
+			{Format: models.StructuredDataJSONLD, Error: "unexpected end of JSON input"},
+		},
+	}
+
+	reporter := page.NewInvalidStructuredDataReporter()
+	if reporter.ErrorType != errors.ErrorInvalidStructuredData {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestInvalidStructuredDataIssues: reportsIssue should be true")
+	}
+}
+
+// Test the IncompleteStructuredData reporter with a pageReport containing complete entities.
+// The reporter should not report the issue.
+func TestIncompleteStructuredDataNoIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+		StructuredData: []models.StructuredData{
+			{Format: models.StructuredDataMicrodata, Type: "Product"},
+		},
+	}
+
+	reporter := page.NewIncompleteStructuredDataReporter()
+	if reporter.ErrorType != errors.ErrorIncompleteStructuredData {
+		t.Errorf("TestNoIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == true {
+		t.Errorf("TestIncompleteStructuredDataNoIssues: reportsIssue should be false")
+	}
+}
+
+// Test the IncompleteStructuredData reporter with a pageReport containing an entity with
+// missing required properties. The reporter should report the issue.
+func TestIncompleteStructuredDataIssues(t *testing.T) {
+	pageReport := &models.PageReport{
+		Crawled: true,
+		StructuredData: []models.StructuredData{
+			{Format: models.StructuredDataMicrodata, Type: "Product", Missing: []string{"name"}},
+		},
+	}
+
+	reporter := page.NewIncompleteStructuredDataReporter()
+	if reporter.ErrorType != errors.ErrorIncompleteStructuredData {
+		t.Errorf("TestIssues: error type is not correct")
+	}
+
+	reportsIssue := reporter.Callback(pageReport, &html.Node{}, &http.Header{})
+
+	if reportsIssue == false {
+		t.Errorf("TestIncompleteStructuredDataIssues: reportsIssue should be true")
+	}
+}
@@ -39,7 +39,7 @@ func NewEmptyTitleReporter() *models.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that checks if the page has a short title.
 // The callback returns true if the page is text/html and has a page title shorter than an specified
 // amount of letters.
-func NewShortTitleReporter() *models.PageIssueReporter {
+func NewShortTitleReporter(length int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -49,7 +49,7 @@ func NewShortTitleReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return len(pageReport.Title) > 0 && len(pageReport.Title) < 20
+		return len(pageReport.Title) > 0 && len(pageReport.Title) < length
 	}
 
 	return &models.PageIssueReporter{
@@ -61,7 +61,7 @@ func NewShortTitleReporter() *models.PageIssueReporter {
 // Returns a report_manager.PageIssueReporter with a callback function that checks if the page has a long title.
 // The callback function returns true if the page is text/html and has a page title longer than an
 // specified amount of letters.
-func NewLongTitleReporter() *models.PageIssueReporter {
+func NewLongTitleReporter(length int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
 		if !pageReport.Crawled {
 			return false
@@ -71,7 +71,7 @@ func NewLongTitleReporter() *models.PageIssueReporter {
 			return false
 		}
 
-		return len(pageReport.Title) > 60
+		return len(pageReport.Title) > length
 	}
 
 	return &models.PageIssueReporter{
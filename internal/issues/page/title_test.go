@@ -67,7 +67,7 @@ func TestShortTitleNoIssues(t *testing.T) {
 			This test should return false if the pageReport description is not short`,
 	}
 
-	reporter := page.NewShortTitleReporter()
+	reporter := page.NewShortTitleReporter(20)
 	if reporter.ErrorType != errors.ErrorShortTitle {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -89,7 +89,7 @@ func TestShortTitleIssues(t *testing.T) {
 		Title:      "Short title",
 	}
 
-	reporter := page.NewShortTitleReporter()
+	reporter := page.NewShortTitleReporter(20)
 	if reporter.ErrorType != errors.ErrorShortTitle {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -111,7 +111,7 @@ func TestLongTitleNoIssues(t *testing.T) {
 		Title:      "This test should return false",
 	}
 
-	reporter := page.NewLongTitleReporter()
+	reporter := page.NewLongTitleReporter(60)
 	if reporter.ErrorType != errors.ErrorLongTitle {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
@@ -137,7 +137,7 @@ func TestLongTitleIssues(t *testing.T) {
 			This test should return false if the pageReport description is not short`,
 	}
 
-	reporter := page.NewLongTitleReporter()
+	reporter := page.NewLongTitleReporter(60)
 	if reporter.ErrorType != errors.ErrorLongTitle {
 		t.Errorf("TestNoIssues: error type is not correct")
 	}
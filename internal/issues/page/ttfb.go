@@ -10,10 +10,11 @@ import (
 )
 
 // Returns a report_manager.PageIssueReporter with a callback function that
-// checks if the TTFB. The callback returns true if the page's time to first byte is slow.
-func NewSlowTTFBReporter() *models.PageIssueReporter {
+// checks if the TTFB. The callback returns true if the page's time to first byte is slower
+// than the specified amount of milliseconds.
+func NewSlowTTFBReporter(ttfb int) *models.PageIssueReporter {
 	c := func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
-		return pageReport.TTFB > 800
+		return pageReport.TTFB > ttfb
 	}
 
 	return &models.PageIssueReporter{
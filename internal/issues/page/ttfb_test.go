@@ -19,7 +19,7 @@ func TestNoSlowTTFB(t *testing.T) {
 		TTFB: 100,
 	}
 
-	reporter := page.NewSlowTTFBReporter()
+	reporter := page.NewSlowTTFBReporter(800)
 	if reporter.ErrorType != errors.ErrorSlowTTFB {
 		t.Errorf("TestNoSlowTTFB: error type is not correct")
 	}
@@ -39,7 +39,7 @@ func TestSlowTTFB(t *testing.T) {
 		TTFB: 1000,
 	}
 
-	reporter := page.NewSlowTTFBReporter()
+	reporter := page.NewSlowTTFBReporter(800)
 	if reporter.ErrorType != errors.ErrorSlowTTFB {
 		t.Errorf("TestNoSlowTTFB: error type is not correct")
 	}
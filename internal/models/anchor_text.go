@@ -0,0 +1,18 @@
+package models
+
+// AnchorText contains the number of links using an anchor text and the number of pages
+// containing those links.
+type AnchorText struct {
+	Text  string
+	Links int
+	Pages int
+}
+
+// ExportAnchorText contains the number of links using an anchor text and the number
+// of pages containing and receiving those links.
+type ExportAnchorText struct {
+	Text    string
+	Links   int
+	Sources int
+	Targets int
+}
@@ -0,0 +1,36 @@
+package models
+
+// Broken link types. Links are the internal links in the page, canonical and hreflang are
+// the page's alternate URLs, and the rest of the types are the resources loaded by the page.
+const (
+	BrokenLinkLink      = "link"
+	BrokenLinkImage     = "image"
+	BrokenLinkScript    = "script"
+	BrokenLinkStyle     = "style"
+	BrokenLinkIframe    = "iframe"
+	BrokenLinkAudio     = "audio"
+	BrokenLinkVideo     = "video"
+	BrokenLinkCanonical = "canonical"
+	BrokenLinkHreflang  = "hreflang"
+)
+
+// BrokenLink is a reference from a source page to a target URL that responded with an error
+// status code. Text is the anchor text of links or the alt text of images, and Sources is the
+// number of distinct source pages referencing the target URL.
+type BrokenLink struct {
+	PageReportId int64
+	SourceURL    string
+	URL          string
+	Text         string
+	Position     string
+	Type         string
+	StatusCode   int
+	Sources      int
+}
+
+type BrokenLinkView struct {
+	ProjectView *ProjectView
+	BrokenLinks []BrokenLink
+	Paginator   Paginator
+	Sort        string
+}
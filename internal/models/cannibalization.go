@@ -0,0 +1,13 @@
+package models
+
+// CannibalizationCluster is a group of indexable pages in the same language that compete for the
+// same keywords. Terms contains the normalized terms shared by all the pages in the cluster.
+type CannibalizationCluster struct {
+	Terms       []string
+	PageReports []PageReport
+}
+
+type CannibalizationView struct {
+	ProjectView *ProjectView
+	Clusters    []CannibalizationCluster
+}
@@ -0,0 +1,9 @@
+package models
+
+// ClickPathNode contains the minimum number of clicks needed to reach a page from the
+// start URL and the id of the previous page in one of the shortest paths. The start
+// page and the pages that can't be reached have no previous page.
+type ClickPathNode struct {
+	Depth  int
+	Parent int64
+}
@@ -0,0 +1,35 @@
+package models
+
+// CustomIssueRule is a project's issue defined by the user. An issue is reported in the pages
+// where the condition matches. The condition's Source defines where the value is taken from:
+// a PageReport field, an extraction rule's value or a response header, all of them identified
+// by the Subject. The Operator compares that value with the rule's Value. In XPath rules the
+// Subject is the XPath expression, and the Operator defines if the issue is reported when the
+// expression matches or when it doesn't.
+type CustomIssueRule struct {
+	Id          int64
+	ProjectId   int64
+	IssueTypeId int
+	Name        string
+	Priority    int
+	Source      string
+	Subject     string
+	Operator    string
+	Value       string
+}
+
+const (
+	CustomIssueField      = "field"
+	CustomIssueExtraction = "extraction"
+	CustomIssueHeader     = "header"
+	CustomIssueXPath      = "xpath"
+
+	CustomIssueEquals      = "eq"
+	CustomIssueNotEquals   = "neq"
+	CustomIssueContains    = "contains"
+	CustomIssueNotContains = "not_contains"
+	CustomIssueGreater     = "gt"
+	CustomIssueLess        = "lt"
+	CustomIssueExists      = "exists"
+	CustomIssueNotExists   = "not_exists"
+)
@@ -4,6 +4,7 @@ type ExportLink struct {
 	Origin      string
 	Destination string
 	Text        string
+	Position    string
 }
 
 type ExportImage struct {
@@ -45,7 +46,31 @@ type ExportHreflang struct {
 }
 
 type ExportIssue struct {
-	Url      string
-	Type     string
-	Priority int
+	Url        string
+	Type       string
+	Name       string
+	Priority   int
+	Suppressed bool
+}
+
+type ExportExtraction struct {
+	Origin string
+	Name   string
+	Value  string
+}
+
+type ExportGraphNode struct {
+	Id         int64
+	URL        string
+	StatusCode int
+	Depth      int
+	Indexable  bool
+	LinkScore  float64
+}
+
+type ExportGraphEdge struct {
+	Source   int64
+	Target   int64
+	NoFollow bool
+	Text     string
 }
@@ -0,0 +1,31 @@
+package models
+
+// ExtractionRule is a project's custom rule used to extract data from the crawled pages.
+// The Expression is an XPath, a CSS selector or a regular expression depending on the
+// rule Type, and the Mode defines if the first match, all the matches or the number of
+// matches is extracted.
+type ExtractionRule struct {
+	Id         int64
+	ProjectId  int64
+	Name       string
+	Type       string
+	Expression string
+	Mode       string
+}
+
+// Extraction is the value extracted from a page by an ExtractionRule.
+type Extraction struct {
+	RuleId int64
+	Name   string
+	Value  string
+}
+
+const (
+	ExtractionXPath = "xpath"
+	ExtractionCSS   = "css"
+	ExtractionRegex = "regex"
+
+	ExtractionFirst = "first"
+	ExtractionAll   = "all"
+	ExtractionCount = "count"
+)
@@ -0,0 +1,14 @@
+package models
+
+// HostIssues contains the number of crawled HTML pages of a host and the number of the host's
+// pages affected by each issue type.
+type HostIssues struct {
+	Host   string
+	Pages  int
+	Issues []HostIssueCount
+}
+
+type HostIssueCount struct {
+	ErrorType string
+	Count     int
+}
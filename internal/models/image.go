@@ -1,6 +1,21 @@
 package models
 
+// Image is an image referenced in an HTML page. Width and Height are the values of the
+// width and height attributes, or 0 if they are not set. Srcset is true if the image is
+// one of the candidates in a srcset attribute.
 type Image struct {
-	URL string
-	Alt string
+	URL    string
+	Alt    string
+	Width  int
+	Height int
+	Srcset bool
+
+	// Data of the crawled image resource, which is only loaded in the page report's images tab.
+	// Issues contains the names of the image's issue types.
+	IntrinsicWidth  int
+	IntrinsicHeight int
+	StatusCode      int
+	MediaType       string
+	Size            int64
+	Issues          []string
 }
@@ -8,7 +8,9 @@ type Issue struct {
 
 type IssueGroup struct {
 	ErrorType string
+	Name      string
 	Priority  int
+	Category  string
 	Count     int
 }
 
@@ -17,4 +19,7 @@ type IssueCount struct {
 	AlertIssues    []IssueGroup
 	WarningIssues  []IssueGroup
 	PassedIssues   []IssueGroup
+
+	// Issues of the accessibility category, which are listed apart from the other issues.
+	AccessibilityIssues []IssueGroup
 }
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// IssueSuppression marks the issues of a type as accepted in a project, so they are not
+// reported or counted. It applies either to a single page URL or to all the URLs matching
+// a Pattern, where the "*" character matches any sequence of characters.
+type IssueSuppression struct {
+	Id        int64
+	ProjectId int64
+	ErrorType string
+	IssueName string
+	URL       string
+	Pattern   string
+	Comment   string
+	UserId    int
+	Author    string
+	Created   time.Time
+}
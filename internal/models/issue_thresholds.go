@@ -0,0 +1,22 @@
+package models
+
+// IssueThresholds contains the values used by the issue reporters to decide whether
+// a page has an issue. They can be changed in each project's settings.
+type IssueThresholds struct {
+	ProjectId            int64
+	MinWords             int   // Pages with less words have little content.
+	MaxDOMSize           int   // Pages with more HTML nodes have an excessive DOM size.
+	MinTitleLength       int   // Shorter titles are reported as short.
+	MaxTitleLength       int   // Longer titles are reported as long.
+	MinDescriptionLength int   // Shorter descriptions are reported as short.
+	MaxDescriptionLength int   // Longer descriptions are reported as long.
+	MaxTTFB              int   // Slow time to first byte in milliseconds.
+	MaxImageSize         int64 // Large image size in bytes.
+	MaxAltTextLength     int   // Longer alt texts are reported as long.
+	MaxLinks             int   // Pages with more links have too many links.
+	MinSimilarity        int   // Pages with a higher text similarity percentage are near duplicates.
+	MaxPageWeight        int64 // Heavy page weight budget in bytes, including the page's resources.
+	MaxScriptsWeight     int64 // Heavy scripts weight budget in bytes.
+	MaxPageRequests      int   // Pages loading more resources exceed the requests budget.
+	MaxLegacyImageSize   int64 // Larger images in legacy formats are reported in bytes.
+}
@@ -0,0 +1,13 @@
+package models
+
+// IssueTypeSetting contains a project's settings for an issue type. Disabled issue types
+// are not reported in the project's crawls, and the Priority replaces the issue type's
+// DefaultPriority in the project. Name is only set in the project's custom issues.
+type IssueTypeSetting struct {
+	IssueTypeId     int
+	Type            string
+	Name            string
+	DefaultPriority int
+	Priority        int
+	Disabled        bool
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Status of the work on a project's issue type.
+const (
+	IssueStatusOpen       = "open"
+	IssueStatusInProgress = "in_progress"
+	IssueStatusFixed      = "fixed"
+	IssueStatusVerified   = "verified"
+)
+
+// IssueWorkflow tracks the work on an issue type in a project. Issue types without a
+// workflow are open. The status is set to verified when a completed crawl no longer reports
+// the issue type. A zero DueDate means the issue type has no due date.
+type IssueWorkflow struct {
+	ProjectId  int64
+	ErrorType  string
+	Status     string
+	AssigneeId int
+	Assignee   string
+	DueDate    time.Time
+	Comments   []IssueComment
+}
+
+// IssueComment is a comment in the thread of an issue type's workflow.
+type IssueComment struct {
+	Id        int64
+	ProjectId int64
+	ErrorType string
+	UserId    int
+	Author    string
+	Comment   string
+	Created   time.Time
+}
@@ -7,8 +7,15 @@ type (
 	}
 
 	IssuesView struct {
-		ProjectView   *ProjectView
-		Eid           string
-		PaginatorView PaginatorView
+		ProjectView    *ProjectView
+		Eid            string
+		IssueName      string
+		Suppressed     bool
+		PaginatorView  PaginatorView
+		Workflow       *IssueWorkflow
+		Assignees      []User
+		Statuses       []string
+		WorkflowError  bool
+		NearDuplicates map[int64][]NearDuplicate
 	}
 )
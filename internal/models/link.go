@@ -14,4 +14,5 @@ type Link struct {
 	Sponsored  bool
 	UGC        bool
 	StatusCode int
+	Position   string
 }
@@ -0,0 +1,20 @@
+package models
+
+// LinkGraph contains the internal link graph of a crawl used to compute the link scores.
+// Pages contains the ids of all the page reports. In Links the target is 0 if the linked URL
+// is not one of the crawled pages. Redirects and Canonicals map the id of a page report to
+// the id of the page it redirects or is canonicalized to.
+type LinkGraph struct {
+	Pages      []int64
+	Links      []LinkGraphEdge
+	Redirects  map[int64]int64
+	Canonicals map[int64]int64
+}
+
+// LinkGraphEdge is a link in the LinkGraph. NoFollow is true if the link or the
+// page containing it have the nofollow attribute.
+type LinkGraphEdge struct {
+	From     int64
+	To       int64
+	NoFollow bool
+}
@@ -0,0 +1,28 @@
+package models
+
+// Positions of a link in the page depending on the element containing it.
+const (
+	LinkPositionNavigation = "navigation"
+	LinkPositionHeader     = "header"
+	LinkPositionFooter     = "footer"
+	LinkPositionAside      = "aside"
+	LinkPositionContent    = "content"
+)
+
+// LinkPositions contains all the link positions.
+var LinkPositions = []string{
+	LinkPositionNavigation,
+	LinkPositionHeader,
+	LinkPositionFooter,
+	LinkPositionAside,
+	LinkPositionContent,
+}
+
+// LinkPositionRule is a project's custom rule used to set the position of the links
+// contained in the elements matched by the XPath expression.
+type LinkPositionRule struct {
+	Id        int64
+	ProjectId int64
+	XPath     string
+	Position  string
+}
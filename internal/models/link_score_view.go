@@ -0,0 +1,7 @@
+package models
+
+type LinkScoreView struct {
+	ProjectView   *ProjectView
+	Threshold     int
+	PaginatorView PaginatorView
+}
@@ -0,0 +1,32 @@
+package models
+
+// Loading finding types. Blocking scripts and head stylesheets delay the first render of the
+// page, unused preloads waste bandwidth, and the image loading attribute should make the
+// main image load eagerly and the images below the fold load lazily.
+const (
+	LoadingBlockingScript = "blocking_script"
+	LoadingHeadStylesheet = "head_stylesheet"
+	LoadingUnusedPreload  = "unused_preload"
+	LoadingLazyLCPImage   = "lazy_lcp_image"
+	LoadingEagerImage     = "eager_image"
+)
+
+const (
+	// Pages with more stylesheets in the head element delay their first render.
+	MaxHeadStylesheets = 4
+
+	// Images after this number of images in the page are considered to be below the fold.
+	LazyLoadMinImages = 5
+
+	// Images with a width or height attribute lower than this number of pixels are not
+	// considered as the largest contentful paint candidate.
+	MinLCPImageSize = 150
+)
+
+// LoadingFinding is an element of the page that slows down its loading.
+// Element is the html element or attribute referencing the resource in URL.
+type LoadingFinding struct {
+	URL     string
+	Type    string
+	Element string
+}
@@ -0,0 +1,17 @@
+package models
+
+// Mixed content types. Active mixed content can modify the page, such as scripts or
+// stylesheets, and is blocked by the browsers. Passive mixed content, such as images or
+// videos, is displayed with a warning.
+const (
+	MixedContentActive  = "active"
+	MixedContentPassive = "passive"
+)
+
+// MixedContent is a resource loaded with the insecure http scheme from an https page.
+// Element is the html element or attribute referencing the resource.
+type MixedContent struct {
+	URL     string
+	Type    string
+	Element string
+}
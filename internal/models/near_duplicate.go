@@ -0,0 +1,9 @@
+package models
+
+// NearDuplicate is a page with a text similar to another page. Similarity is
+// the percentage of equal bits in the SimHash of both pages.
+type NearDuplicate struct {
+	Id         int64
+	URL        string
+	Similarity int
+}
@@ -0,0 +1,44 @@
+package models
+
+// PageWeight contains the weight in bytes of an HTML page and its resources. The weight of each
+// resource type only includes the crawled resources, while the counts include all the
+// resources referenced in the page.
+type PageWeight struct {
+	PageReportId int64
+	URL          string
+	Title        string
+	Document     int64
+	Scripts      int64
+	Styles       int64
+	Images       int64
+	Fonts        int64
+	Total        int64
+	ScriptsCount int
+	StylesCount  int
+	ImagesCount  int
+	FontsCount   int
+}
+
+// Requests returns the number of resources loaded by the page.
+func (w PageWeight) Requests() int {
+	return w.ScriptsCount + w.StylesCount + w.ImagesCount + w.FontsCount
+}
+
+// PageWeightData contains the crawl's data needed to compute the weight of the HTML pages.
+// The resources are the URLs referenced in each page report, and the sizes of the crawled
+// page reports are found using their URL.
+type PageWeightData struct {
+	Pages   []int64
+	URLs    map[string]int64
+	Sizes   map[int64]int64
+	Scripts map[int64][]string
+	Styles  map[int64][]string
+	Images  map[int64][]string
+	Fonts   map[int64][]string
+}
+
+type PageWeightView struct {
+	ProjectView *ProjectView
+	PageWeights []PageWeight
+	Paginator   Paginator
+}
@@ -27,9 +27,13 @@ type PageReport struct {
 	Words              int
 	Hreflangs          []Hreflang
 	Size               int64
+	TransferSize       int64
+	ImageWidth         int
+	ImageHeight        int
 	Images             []Image
 	Scripts            []string
 	Styles             []string
+	Fonts              []string
 	Iframes            []string
 	Audios             []string
 	Videos             []Video
@@ -39,6 +43,17 @@ type PageReport struct {
 	InternalLinks      []InternalLink
 	Depth              int
 	BodyHash           string
+	SimHash            uint64
+	MainWords          int
+	MainTextHash       string
+	MainTextExcerpt    string
+	LinkScore          float64
+	ClickDepth         int
+	MixedContent       []MixedContent
+	LoadingFindings    []LoadingFinding
 	Timeout            bool
 	TTFB               int
+	StructuredData     []StructuredData
+	SocialTags         []SocialTag
+	Extractions        []Extraction
 }
@@ -2,8 +2,10 @@ package models
 
 type PageReportView struct {
 	PageReport PageReport
-	ErrorTypes []string
+	ErrorTypes []IssueGroup
 	InLinks    []InternalLink
 	Redirects  []PageReport
+	Anchors    []AnchorText
+	ClickPath  []PageReport
 	Paginator  Paginator
 }
@@ -0,0 +1,19 @@
+package models
+
+// Phrase kinds. Generic anchors are anchor texts that don't describe the linked page, such as
+// "click here", and soft 404 phrases are texts that, found in the title or H1 of a page, suggest
+// it is a not found page, such as "page not found".
+const (
+	PhraseGenericAnchor = "generic_anchor"
+	PhraseSoft404       = "soft404"
+)
+
+// Phrase is a text of one of the phrase kinds in a language. Projects can add phrases for each
+// language to the default ones.
+type Phrase struct {
+	Id        int64
+	ProjectId int64
+	Kind      string
+	Lang      string
+	Text      string
+}
@@ -0,0 +1,18 @@
+package models
+
+// SiteStructureNode is a directory in the site structure tree. Pages is the number of crawled
+// pages in the directory and its subdirectories. If there's a crawled page with the directory's
+// path, PageReportId contains its id.
+type SiteStructureNode struct {
+	Name         string
+	PageReportId int64
+	StatusCode   int
+	LinkScore    float64
+	Pages        int
+	Children     []*SiteStructureNode
+}
+
+type SiteStructureView struct {
+	ProjectView *ProjectView
+	Root        *SiteStructureNode
+}
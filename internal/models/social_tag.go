@@ -0,0 +1,7 @@
+package models
+
+// SocialTag is an Open Graph or Twitter Card meta tag found in the page's head.
+type SocialTag struct {
+	Property string
+	Content  string
+}
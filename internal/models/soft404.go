@@ -0,0 +1,9 @@
+package models
+
+// NotFoundReference contains the main content's SimHash and number of words of the response to
+// a URL that doesn't exist in the site. Pages with similar content are likely not found pages.
+// MainWords is 0 if the URL couldn't be fetched or it has no content.
+type NotFoundReference struct {
+	SimHash   uint64
+	MainWords int
+}
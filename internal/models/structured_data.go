@@ -0,0 +1,33 @@
+package models
+
+// StructuredData is an entity found in a page using any of the supported
+// structured data formats (JSON-LD, Microdata or RDFa).
+type StructuredData struct {
+	Format     string
+	Type       string
+	Properties map[string]interface{}
+	Missing    []string // Required properties missing in the entity
+	Error      string   // Error found while parsing the entity
+}
+
+const (
+	StructuredDataJSONLD    = "json-ld"
+	StructuredDataMicrodata = "microdata"
+	StructuredDataRDFa      = "rdfa"
+)
+
+// StructuredDataEntity is a structured data entity found in one of the crawled pages.
+type StructuredDataEntity struct {
+	StructuredData
+	PageReportId int64
+	URL          string
+}
+
+type StructuredDataView struct {
+	ProjectView *ProjectView
+	Entities    []StructuredDataEntity
+	Types       []string
+	Type        string
+	Invalid     bool
+	Paginator   Paginator
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+	"math"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type BrokenLinkRepository struct {
+	DB *sql.DB
+}
+
+// brokenLinkOrder contains the ORDER BY clauses of the broken links sort options.
+var brokenLinkOrder = map[string]string{
+	"sources": "broken_links.sources DESC, broken_links.url ASC, pagereports.url ASC",
+	"url":     "broken_links.url ASC, pagereports.url ASC",
+}
+
+// FindCrawlBrokenLinks returns the references of the crawl's pages to crawled URLs with an error
+// status code. Each query returns the source page report id, the target URL, the anchor text,
+// the link position and the target's status code.
+func (ds *BrokenLinkRepository) FindCrawlBrokenLinks(crawlId int64) []models.BrokenLink {
+	links := []models.BrokenLink{}
+
+	ds.findBrokenLinks(&links, models.BrokenLinkLink, `
+		SELECT links.pagereport_id, links.url, COALESCE(links.text, ''), links.position, pagereports.status_code
+		FROM links
+		INNER JOIN pagereports ON pagereports.url_hash = links.url_hash AND pagereports.crawl_id = links.crawl_id
+		WHERE links.crawl_id = ? AND pagereports.crawled = 1 AND pagereports.status_code >= 400`, crawlId)
+
+	ds.findBrokenLinks(&links, models.BrokenLinkImage, `
+		SELECT images.pagereport_id, images.url, COALESCE(images.alt, ''), '', pagereports.status_code
+		FROM images
+		INNER JOIN pagereports ON pagereports.url_hash = SHA2(images.url, 256) AND pagereports.crawl_id = images.crawl_id
+		WHERE images.crawl_id = ? AND pagereports.crawled = 1 AND pagereports.status_code >= 400`, crawlId)
+
+	resources := []struct {
+		linkType string
+		table    string
+	}{
+		{models.BrokenLinkScript, "scripts"},
+		{models.BrokenLinkStyle, "styles"},
+		{models.BrokenLinkIframe, "iframes"},
+		{models.BrokenLinkAudio, "audios"},
+		{models.BrokenLinkVideo, "videos"},
+	}
+
+	for _, r := range resources {
+		table := r.table
+		ds.findBrokenLinks(&links, r.linkType, `
+			SELECT `+table+`.pagereport_id, `+table+`.url, '', '', pagereports.status_code
+			FROM `+table+`
+			INNER JOIN pagereports ON pagereports.url_hash = SHA2(`+table+`.url, 256) AND pagereports.crawl_id = `+table+`.crawl_id
+			WHERE `+table+`.crawl_id = ? AND pagereports.crawled = 1 AND pagereports.status_code >= 400`, crawlId)
+	}
+
+	ds.findBrokenLinks(&links, models.BrokenLinkCanonical, `
+		SELECT pr.id, pr.canonical, '', '', pr2.status_code
+		FROM pagereports AS pr
+		INNER JOIN pagereports AS pr2 ON pr2.url_hash = SHA2(pr.canonical, 256) AND pr2.crawl_id = pr.crawl_id
+		WHERE pr.crawl_id = ? AND pr.canonical != '' AND pr.canonical != pr.url
+			AND pr2.crawled = 1 AND pr2.status_code >= 400`, crawlId)
+
+	ds.findBrokenLinks(&links, models.BrokenLinkHreflang, `
+		SELECT hreflangs.pagereport_id, hreflangs.to_url, '', '', pagereports.status_code
+		FROM hreflangs
+		INNER JOIN pagereports ON pagereports.url_hash = hreflangs.to_hash AND pagereports.crawl_id = hreflangs.crawl_id
+		WHERE hreflangs.crawl_id = ? AND pagereports.crawled = 1 AND pagereports.status_code >= 400`, crawlId)
+
+	return links
+}
+
+// SaveBrokenLinks stores the crawl's broken links. Links that can't be stored are logged and
+// skipped so they don't prevent the rest of the links from being stored.
+func (ds *BrokenLinkRepository) SaveBrokenLinks(crawlId int64, links []models.BrokenLink) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO broken_links (
+			pagereport_id,
+			crawl_id,
+			url,
+			text,
+			position,
+			type,
+			status_code,
+			sources
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, l := range links {
+		_, err := stmt.Exec(
+			l.PageReportId,
+			crawlId,
+			l.URL,
+			l.Text,
+			l.Position,
+			l.Type,
+			l.StatusCode,
+			l.Sources,
+		)
+		if err != nil {
+			log.Printf("SaveBrokenLinks: %s: %v\n", l.URL, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetNumberOfPagesForBrokenLinks returns the number of pages in the paginator of the
+// crawl's broken links.
+func (ds *BrokenLinkRepository) GetNumberOfPagesForBrokenLinks(crawlId int64) int {
+	row := ds.DB.QueryRow("SELECT count(id) FROM broken_links WHERE crawl_id = ?", crawlId)
+	var c int
+	if err := row.Scan(&c); err != nil {
+		log.Printf("GetNumberOfPagesForBrokenLinks: %v\n", err)
+	}
+	var f float64 = float64(c) / float64(paginationMax)
+	return int(math.Ceil(f))
+}
+
+// FindBrokenLinks returns a paginated slice with the crawl's broken links sorted by the
+// specified sort option. By default they are sorted by the number of source pages.
+func (ds *BrokenLinkRepository) FindBrokenLinks(crawlId int64, p int, sort string) []models.BrokenLink {
+	max := paginationMax
+	offset := max * (p - 1)
+	links := []models.BrokenLink{}
+
+	order, ok := brokenLinkOrder[sort]
+	if !ok {
+		order = brokenLinkOrder["sources"]
+	}
+
+	query := `
+		SELECT
+			broken_links.pagereport_id,
+			pagereports.url,
+			broken_links.url,
+			broken_links.text,
+			broken_links.position,
+			broken_links.type,
+			broken_links.status_code,
+			broken_links.sources
+		FROM broken_links
+		INNER JOIN pagereports ON pagereports.id = broken_links.pagereport_id
+		WHERE broken_links.crawl_id = ?
+		ORDER BY ` + order + `
+		LIMIT ?, ?`
+
+	rows, err := ds.DB.Query(query, crawlId, offset, max)
+	if err != nil {
+		log.Printf("FindBrokenLinks: %v\n", err)
+		return links
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		l := models.BrokenLink{}
+		err := rows.Scan(
+			&l.PageReportId,
+			&l.SourceURL,
+			&l.URL,
+			&l.Text,
+			&l.Position,
+			&l.Type,
+			&l.StatusCode,
+			&l.Sources,
+		)
+		if err != nil {
+			log.Printf("FindBrokenLinks: %v\n", err)
+			continue
+		}
+
+		links = append(links, l)
+	}
+
+	return links
+}
+
+// findBrokenLinks appends the broken links returned by the query to the links slice
+// with the specified type.
+func (ds *BrokenLinkRepository) findBrokenLinks(links *[]models.BrokenLink, t, query string, crawlId int64) {
+	rows, err := ds.DB.Query(query, crawlId)
+	if err != nil {
+		log.Printf("FindCrawlBrokenLinks: %v\n", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		l := models.BrokenLink{Type: t}
+		err := rows.Scan(&l.PageReportId, &l.URL, &l.Text, &l.Position, &l.StatusCode)
+		if err != nil {
+			log.Printf("FindCrawlBrokenLinks: %v\n", err)
+			continue
+		}
+
+		*links = append(*links, l)
+	}
+}
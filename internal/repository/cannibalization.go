@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type CannibalizationRepository struct {
+	DB *sql.DB
+}
+
+// FindCannibalizationPageReports returns the indexable HTML pages of a crawl with the data
+// needed to find the pages competing for the same keywords.
+func (ds *CannibalizationRepository) FindCannibalizationPageReports(cid int64) []models.PageReport {
+	pageReports := []models.PageReport{}
+
+	query := `
+		SELECT id, url, title, h1, lang, main_words, link_score
+		FROM pagereports
+		WHERE crawl_id = ? AND crawled = 1 AND media_type = "text/html"
+		AND status_code >= 200 AND status_code < 300 AND noindex = 0
+		AND (canonical = "" OR canonical = url)
+		ORDER BY url`
+
+	rows, err := ds.DB.Query(query, cid)
+	if err != nil {
+		log.Printf("FindCannibalizationPageReports: %v\n", err)
+		return pageReports
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.PageReport{}
+		if err := rows.Scan(&p.Id, &p.URL, &p.Title, &p.H1, &p.Lang, &p.MainWords, &p.LinkScore); err != nil {
+			log.Printf("FindCannibalizationPageReports: %v\n", err)
+			continue
+		}
+
+		pageReports = append(pageReports, p)
+	}
+
+	return pageReports
+}
+
+// SaveCannibalizationClusters stores the crawl's cannibalization clusters. Each page is stored
+// with the position of its cluster, its position in the cluster and the cluster's shared terms.
+func (ds *CannibalizationRepository) SaveCannibalizationClusters(crawlId int64, clusters []models.CannibalizationCluster) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO cannibalization_pages (
+			pagereport_id,
+			crawl_id,
+			cluster,
+			position,
+			terms
+		)
+		VALUES (?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for c, cluster := range clusters {
+		terms := strings.Join(cluster.Terms, ",")
+		for i, p := range cluster.PageReports {
+			if _, err := stmt.Exec(p.Id, crawlId, c, i, terms); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// FindCannibalizationClusters returns the stored cannibalization clusters of the crawl in the
+// same order they were saved.
+func (ds *CannibalizationRepository) FindCannibalizationClusters(crawlId int64) []models.CannibalizationCluster {
+	clusters := []models.CannibalizationCluster{}
+
+	query := `
+		SELECT
+			cannibalization_pages.cluster,
+			cannibalization_pages.terms,
+			pagereports.id,
+			pagereports.url,
+			pagereports.title,
+			pagereports.h1,
+			pagereports.lang,
+			pagereports.main_words,
+			pagereports.link_score
+		FROM cannibalization_pages
+		INNER JOIN pagereports ON pagereports.id = cannibalization_pages.pagereport_id
+		WHERE cannibalization_pages.crawl_id = ?
+		ORDER BY cannibalization_pages.cluster, cannibalization_pages.position`
+
+	rows, err := ds.DB.Query(query, crawlId)
+	if err != nil {
+		log.Printf("FindCannibalizationClusters: %v\n", err)
+		return clusters
+	}
+	defer rows.Close()
+
+	current := -1
+	for rows.Next() {
+		var cluster int
+		var terms string
+		p := models.PageReport{}
+		err := rows.Scan(&cluster, &terms, &p.Id, &p.URL, &p.Title, &p.H1, &p.Lang, &p.MainWords, &p.LinkScore)
+		if err != nil {
+			log.Printf("FindCannibalizationClusters: %v\n", err)
+			continue
+		}
+
+		if cluster != current {
+			current = cluster
+			c := models.CannibalizationCluster{}
+			if terms != "" {
+				c.Terms = strings.Split(terms, ",")
+			}
+			clusters = append(clusters, c)
+		}
+
+		last := &clusters[len(clusters)-1]
+		last.PageReports = append(last.PageReports, p)
+	}
+
+	return clusters
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type ClickPathRepository struct {
+	DB *sql.DB
+}
+
+// FindPageReportIdByURL returns the id of the crawled page report with the specified URL
+// or 0 if it doesn't exist.
+func (ds *ClickPathRepository) FindPageReportIdByURL(crawlId int64, u string) int64 {
+	query := `SELECT id FROM pagereports WHERE url_hash = ? AND crawl_id = ? AND crawled = 1`
+
+	var id int64
+	err := ds.DB.QueryRow(query, Hash(u), crawlId).Scan(&id)
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("FindPageReportIdByURL: %v\n", err)
+	}
+
+	return id
+}
+
+// SaveClickPaths stores the click depth and the previous page in the shortest click path
+// of the crawl's page reports.
+func (ds *ClickPathRepository) SaveClickPaths(crawlId int64, paths map[int64]models.ClickPathNode) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare("UPDATE pagereports SET click_depth = ?, click_parent_id = ? WHERE id = ? AND crawl_id = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for id, n := range paths {
+		if _, err := stmt.Exec(n.Depth, n.Parent, id, crawlId); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -190,9 +190,18 @@ func (ds *CrawlRepository) DeleteCrawlData(crawl *models.Crawl) {
 	deleteFunc(crawl.Id, "images")
 	deleteFunc(crawl.Id, "scripts")
 	deleteFunc(crawl.Id, "styles")
+	deleteFunc(crawl.Id, "fonts")
 	deleteFunc(crawl.Id, "iframes")
 	deleteFunc(crawl.Id, "audios")
 	deleteFunc(crawl.Id, "videos")
+	deleteFunc(crawl.Id, "structured_data")
+	deleteFunc(crawl.Id, "social_tags")
+	deleteFunc(crawl.Id, "extractions")
+	deleteFunc(crawl.Id, "mixed_content")
+	deleteFunc(crawl.Id, "loading_findings")
+	deleteFunc(crawl.Id, "page_weights")
+	deleteFunc(crawl.Id, "broken_links")
+	deleteFunc(crawl.Id, "cannibalization_pages")
 	deleteFunc(crawl.Id, "pagereports")
 }
 
@@ -274,6 +283,30 @@ func (ds *CrawlRepository) DeleteUnfinishedCrawls() {
 	log.Printf("Deleted %d unfinished crawls.", count)
 }
 
+// UpdateCrawlIssueCounts updates the number of issues of each priority stored in the crawl.
+func (ds *CrawlRepository) UpdateCrawlIssueCounts(crawl *models.Crawl) {
+	query := `
+		UPDATE crawls
+		SET
+			critical_issues = ?,
+			alert_issues = ?,
+			warning_issues = ?,
+			total_issues = ?
+		WHERE id = ?`
+
+	_, err := ds.DB.Exec(
+		query,
+		crawl.CriticalIssues,
+		crawl.AlertIssues,
+		crawl.WarningIssues,
+		crawl.TotalIssues,
+		crawl.Id,
+	)
+	if err != nil {
+		log.Printf("UpdateCrawlIssueCounts: %v\n", err)
+	}
+}
+
 // SaveIssuesCount stores the total number of issues as well as the total issues by priority for
 // the crawl specified in the "crawlId" parameter.
 func (ds *CrawlRepository) UpdateCrawl(crawl *models.Crawl) {
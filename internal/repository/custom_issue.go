@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type CustomIssueRepository struct {
+	DB *sql.DB
+}
+
+// FindCustomIssueRules returns all the custom issue rules of a project
+// including the priority of the rule's issue type.
+func (ds *CustomIssueRepository) FindCustomIssueRules(projectId int64) []models.CustomIssueRule {
+	rules := []models.CustomIssueRule{}
+
+	query := `
+		SELECT
+			custom_issue_rules.id,
+			custom_issue_rules.project_id,
+			custom_issue_rules.issue_type_id,
+			custom_issue_rules.name,
+			issue_types.priority,
+			custom_issue_rules.source,
+			custom_issue_rules.subject,
+			custom_issue_rules.operator,
+			custom_issue_rules.value
+		FROM custom_issue_rules
+		INNER JOIN issue_types ON issue_types.id = custom_issue_rules.issue_type_id
+		WHERE custom_issue_rules.project_id = ?
+		ORDER BY custom_issue_rules.id`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return rules
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := models.CustomIssueRule{}
+		err := rows.Scan(&r.Id, &r.ProjectId, &r.IssueTypeId, &r.Name, &r.Priority, &r.Source, &r.Subject, &r.Operator, &r.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// SaveCustomIssueRule stores a new custom issue rule and sets its id. Each rule has its own
+// issue type so the issues it reports are stored and counted as the built-in issues.
+// The issue type belongs to the rule's project and is named CUSTOM_ISSUE_ followed by its id.
+func (ds *CustomIssueRepository) SaveCustomIssueRule(r *models.CustomIssueRule) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("INSERT INTO issue_types (type, priority, project_id) VALUES (?, ?, ?)", "", r.Priority, r.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	issueTypeId, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("UPDATE issue_types SET type = ? WHERE id = ?", fmt.Sprintf("CUSTOM_ISSUE_%d", issueTypeId), issueTypeId)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO custom_issue_rules (project_id, issue_type_id, name, source, subject, operator, value)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	res, err = tx.Exec(
+		query,
+		r.ProjectId,
+		issueTypeId,
+		Truncate(r.Name, 256),
+		r.Source,
+		Truncate(r.Subject, 2048),
+		r.Operator,
+		Truncate(r.Value, 2048),
+	)
+	if err != nil {
+		return err
+	}
+
+	r.Id, err = res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	r.IssueTypeId = int(issueTypeId)
+
+	return tx.Commit()
+}
+
+// DeleteCustomIssueRule deletes a project's custom issue rule by removing its issue type.
+// The rule and the issues it reported are removed by the database as well.
+func (ds *CustomIssueRepository) DeleteCustomIssueRule(id, projectId int64) error {
+	query := `
+		DELETE issue_types
+		FROM issue_types
+		INNER JOIN custom_issue_rules ON custom_issue_rules.issue_type_id = issue_types.id
+		WHERE custom_issue_rules.id = ? AND custom_issue_rules.project_id = ?`
+
+	_, err := ds.DB.Exec(query, id, projectId)
+
+	return err
+}
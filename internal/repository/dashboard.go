@@ -223,3 +223,78 @@ func (ds *DashboardRepository) GetStatusCodeByDepth(cid int64) []models.StatusCo
 
 	return s
 }
+
+// CountIssuesByHost returns a slice of HostIssues models with the number of crawled HTML pages of
+// each host in the crawl and the number of the host's pages with each issue type of a category.
+func (ds *DashboardRepository) CountIssuesByHost(cid int64, category string) []models.HostIssues {
+	hosts := []models.HostIssues{}
+	index := make(map[string]int)
+
+	pagesQuery := `
+		SELECT
+			SUBSTRING_INDEX(SUBSTRING_INDEX(url, "/", 3), "/", -1) AS host,
+			count(*)
+		FROM pagereports
+		WHERE crawl_id = ? AND crawled = 1 AND media_type = "text/html"
+		GROUP BY host
+		ORDER BY host
+	`
+
+	rows, err := ds.DB.Query(pagesQuery, cid)
+	if err != nil {
+		log.Printf("CountIssuesByHost: %v\n", err)
+		return hosts
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		h := models.HostIssues{}
+		if err := rows.Scan(&h.Host, &h.Pages); err != nil {
+			log.Printf("CountIssuesByHost: %v\n", err)
+			continue
+		}
+
+		index[h.Host] = len(hosts)
+		hosts = append(hosts, h)
+	}
+
+	issuesQuery := `
+		SELECT
+			SUBSTRING_INDEX(SUBSTRING_INDEX(pagereports.url, "/", 3), "/", -1) AS host,
+			issue_types.type,
+			count(DISTINCT pagereports.id) AS c
+		FROM issues
+		INNER JOIN pagereports ON pagereports.id = issues.pagereport_id
+		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
+		WHERE issues.crawl_id = ? AND issue_types.category = ?
+		GROUP BY host, issue_types.type
+		ORDER BY host, c DESC
+	`
+
+	issueRows, err := ds.DB.Query(issuesQuery, cid, category)
+	if err != nil {
+		log.Printf("CountIssuesByHost: %v\n", err)
+		return hosts
+	}
+	defer issueRows.Close()
+
+	for issueRows.Next() {
+		var host string
+		c := models.HostIssueCount{}
+		if err := issueRows.Scan(&host, &c.ErrorType, &c.Count); err != nil {
+			log.Printf("CountIssuesByHost: %v\n", err)
+			continue
+		}
+
+		i, ok := index[host]
+		if !ok {
+			index[host] = len(hosts)
+			hosts = append(hosts, models.HostIssues{Host: host})
+			i = index[host]
+		}
+
+		hosts[i].Issues = append(hosts[i].Issues, c)
+	}
+
+	return hosts
+}
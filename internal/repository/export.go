@@ -22,7 +22,8 @@ func (ds *ExportRepository) ExportLinks(crawl *models.Crawl) <-chan *models.Expo
 				SELECT
 					pagereports.url,
 					links.url,
-					links.text
+					links.text,
+					links.position
 				FROM links
 				LEFT JOIN pagereports ON pagereports.id  = links.pagereport_id
 				WHERE links.crawl_id = ?`
@@ -34,7 +35,7 @@ func (ds *ExportRepository) ExportLinks(crawl *models.Crawl) <-chan *models.Expo
 
 		for rows.Next() {
 			v := &models.ExportLink{}
-			err := rows.Scan(&v.Origin, &v.Destination, &v.Text)
+			err := rows.Scan(&v.Origin, &v.Destination, &v.Text, &v.Position)
 			if err != nil {
 				log.Println(err)
 				continue
@@ -58,7 +59,8 @@ func (ds *ExportRepository) ExportExternalLinks(crawl *models.Crawl) <-chan *mod
 				SELECT
 					pagereports.url,
 					external_links.url,
-					external_links.text
+					external_links.text,
+					external_links.position
 				FROM external_links
 				LEFT JOIN pagereports ON pagereports.id  = external_links.pagereport_id
 				WHERE external_links.crawl_id = ?`
@@ -70,7 +72,7 @@ func (ds *ExportRepository) ExportExternalLinks(crawl *models.Crawl) <-chan *mod
 
 		for rows.Next() {
 			v := &models.ExportLink{}
-			err := rows.Scan(&v.Origin, &v.Destination, &v.Text)
+			err := rows.Scan(&v.Origin, &v.Destination, &v.Text, &v.Position)
 			if err != nil {
 				log.Println(err)
 				continue
@@ -342,12 +344,16 @@ func (ds *ExportRepository) ExportIssues(crawl *models.Crawl) <-chan *models.Exp
 		SELECT
 			pagereports.url,
 			issue_types.type,
-			issue_types.priority
+			COALESCE(custom_issue_rules.name, ''),
+			` + issuePriority + ` AS priority,
+			` + issueSuppressed + ` AS suppressed
 		FROM issues
 			LEFT JOIN  issue_types ON issue_types.id = issues.issue_type_id
+			` + issueTypeOverridesJoin + `
 			LEFT JOIN pagereports ON pagereports.id = issues.pagereport_id
+			LEFT JOIN custom_issue_rules ON custom_issue_rules.issue_type_id = issues.issue_type_id
 		WHERE issues.crawl_id = ?
-		ORDER BY issue_types.priority ASC`
+		ORDER BY priority ASC`
 
 		rows, err := ds.DB.Query(query, crawl.Id)
 		if err != nil {
@@ -356,7 +362,214 @@ func (ds *ExportRepository) ExportIssues(crawl *models.Crawl) <-chan *models.Exp
 
 		for rows.Next() {
 			v := &models.ExportIssue{}
-			err := rows.Scan(&v.Url, &v.Type, &v.Priority)
+			err := rows.Scan(&v.Url, &v.Type, &v.Name, &v.Priority, &v.Suppressed)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send all the values extracted with the project's extraction rules through a read-only channel
+func (ds *ExportRepository) ExportExtractions(crawl *models.Crawl) <-chan *models.ExportExtraction {
+	vStream := make(chan *models.ExportExtraction)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				pagereports.url,
+				extraction_rules.name,
+				extractions.value
+			FROM extractions
+			LEFT JOIN pagereports ON pagereports.id = extractions.pagereport_id
+			INNER JOIN extraction_rules ON extraction_rules.id = extractions.rule_id
+			WHERE extractions.crawl_id = ?
+			ORDER BY extractions.pagereport_id, extraction_rules.id`
+
+		rows, err := ds.DB.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &models.ExportExtraction{}
+			err := rows.Scan(&v.Origin, &v.Name, &v.Value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send all the crawled pages, which are the nodes of the internal link graph, through a read-only channel
+func (ds *ExportRepository) ExportGraphNodes(crawl *models.Crawl) <-chan *models.ExportGraphNode {
+	vStream := make(chan *models.ExportGraphNode)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				id,
+				url,
+				status_code,
+				depth,
+				(
+					media_type = "text/html"
+					AND status_code >= 200 AND status_code < 300
+					AND noindex = 0
+					AND robotstxt_blocked = 0
+					AND (canonical = "" OR canonical = url)
+				) AS indexable,
+				link_score
+			FROM pagereports
+			WHERE crawl_id = ? AND crawled = 1`
+
+		rows, err := ds.DB.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &models.ExportGraphNode{}
+			err := rows.Scan(&v.Id, &v.URL, &v.StatusCode, &v.Depth, &v.Indexable, &v.LinkScore)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send all the internal links between crawled pages, which are the edges of the internal
+// link graph, through a read-only channel
+func (ds *ExportRepository) ExportGraphEdges(crawl *models.Crawl) <-chan *models.ExportGraphEdge {
+	vStream := make(chan *models.ExportGraphEdge)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				links.pagereport_id,
+				pagereports.id,
+				links.nofollow,
+				COALESCE(links.text, "")
+			FROM links
+			INNER JOIN pagereports ON pagereports.url_hash = links.url_hash
+				AND pagereports.crawl_id = links.crawl_id
+				AND pagereports.crawled = 1
+			WHERE links.crawl_id = ?`
+
+		rows, err := ds.DB.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &models.ExportGraphEdge{}
+			err := rows.Scan(&v.Source, &v.Target, &v.NoFollow, &v.Text)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send the anchor texts of all the internal links with their number of links, source pages and
+// target URLs through a read-only channel
+func (ds *ExportRepository) ExportAnchorTexts(crawl *models.Crawl) <-chan *models.ExportAnchorText {
+	vStream := make(chan *models.ExportAnchorText)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				COALESCE(text, '') AS anchor,
+				count(*) AS total,
+				count(DISTINCT pagereport_id),
+				count(DISTINCT url_hash)
+			FROM links
+			WHERE crawl_id = ?
+			GROUP BY anchor
+			ORDER BY total DESC, anchor ASC`
+
+		rows, err := ds.DB.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &models.ExportAnchorText{}
+			err := rows.Scan(&v.Text, &v.Links, &v.Sources, &v.Targets)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+
+			vStream <- v
+		}
+	}()
+
+	return vStream
+}
+
+// Send the broken links sorted by their number of source pages through a read-only channel
+func (ds *ExportRepository) ExportBrokenLinks(crawl *models.Crawl) <-chan *models.BrokenLink {
+	vStream := make(chan *models.BrokenLink)
+
+	go func() {
+		defer close(vStream)
+
+		query := `
+			SELECT
+				pagereports.url,
+				broken_links.url,
+				broken_links.text,
+				broken_links.position,
+				broken_links.type,
+				broken_links.status_code,
+				broken_links.sources
+			FROM broken_links
+			INNER JOIN pagereports ON pagereports.id = broken_links.pagereport_id
+			WHERE broken_links.crawl_id = ?
+			ORDER BY broken_links.sources DESC, broken_links.url ASC, pagereports.url ASC`
+
+		rows, err := ds.DB.Query(query, crawl.Id)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		for rows.Next() {
+			v := &models.BrokenLink{}
+			err := rows.Scan(&v.SourceURL, &v.URL, &v.Text, &v.Position, &v.Type, &v.StatusCode, &v.Sources)
 			if err != nil {
 				log.Println(err)
 				continue
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type ExtractionRepository struct {
+	DB *sql.DB
+}
+
+// FindExtractionRules returns all the extraction rules of a project.
+func (ds *ExtractionRepository) FindExtractionRules(projectId int64) []models.ExtractionRule {
+	rules := []models.ExtractionRule{}
+
+	query := `
+		SELECT id, project_id, name, type, expression, mode
+		FROM extraction_rules
+		WHERE project_id = ?
+		ORDER BY id`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return rules
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := models.ExtractionRule{}
+		err := rows.Scan(&r.Id, &r.ProjectId, &r.Name, &r.Type, &r.Expression, &r.Mode)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// SaveExtractionRule stores a new extraction rule and sets its id.
+func (ds *ExtractionRepository) SaveExtractionRule(r *models.ExtractionRule) error {
+	query := `
+		INSERT INTO extraction_rules (project_id, name, type, expression, mode)
+		VALUES (?, ?, ?, ?, ?)`
+
+	res, err := ds.DB.Exec(query, r.ProjectId, Truncate(r.Name, 256), r.Type, Truncate(r.Expression, 2048), r.Mode)
+	if err != nil {
+		return err
+	}
+
+	r.Id, err = res.LastInsertId()
+
+	return err
+}
+
+// DeleteExtractionRule deletes a project's extraction rule. The extracted values are
+// removed by the database as well.
+func (ds *ExtractionRepository) DeleteExtractionRule(id, projectId int64) error {
+	query := `DELETE FROM extraction_rules WHERE id = ? AND project_id = ?`
+	_, err := ds.DB.Exec(query, id, projectId)
+
+	return err
+}
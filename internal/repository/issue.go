@@ -12,6 +12,39 @@ type IssueRepository struct {
 	DB *sql.DB
 }
 
+// Select expression that returns the name of the custom issue rule that owns an issue type,
+// or an empty string in case of a built-in issue type.
+const customIssueName = `COALESCE((
+	SELECT custom_issue_rules.name
+	FROM custom_issue_rules
+	WHERE custom_issue_rules.issue_type_id = issue_types.id
+), '')`
+
+// Join used to get the issue type overrides of the crawl's project.
+const issueTypeOverridesJoin = `
+	INNER JOIN crawls ON crawls.id = issues.crawl_id
+	LEFT JOIN issue_type_overrides ON issue_type_overrides.issue_type_id = issues.issue_type_id
+		AND issue_type_overrides.project_id = crawls.project_id`
+
+// Select expression that returns the issue type's priority in the crawl's project.
+// It requires the issueTypeOverridesJoin.
+const issuePriority = `COALESCE(issue_type_overrides.priority, issue_types.priority)`
+
+// Condition that is true if the issue has been suppressed in the crawl's project, either
+// for the issue's page URL or for a URL pattern matching it.
+const issueSuppressed = `EXISTS (
+	SELECT issue_suppressions.id
+	FROM issue_suppressions
+	INNER JOIN crawls AS suppressed_crawls ON suppressed_crawls.project_id = issue_suppressions.project_id
+	INNER JOIN pagereports AS suppressed_pagereports ON suppressed_pagereports.id = issues.pagereport_id
+	WHERE suppressed_crawls.id = issues.crawl_id
+		AND issue_suppressions.issue_type_id = issues.issue_type_id
+		AND (
+			issue_suppressions.url_hash = suppressed_pagereports.url_hash
+			OR (issue_suppressions.like_pattern != '' AND suppressed_pagereports.url LIKE issue_suppressions.like_pattern)
+		)
+)`
+
 // SaveIssues inserts the issues it receives in the iStream channel into the database
 // using a batch process.
 func (ds *IssueRepository) SaveIssues(iStream <-chan *models.Issue) {
@@ -48,17 +81,22 @@ func (ds *IssueRepository) SaveIssues(iStream <-chan *models.Issue) {
 }
 
 // FindIssuesByTypeAndPriority returns an IssueGroup model with all the issues detected in a crawl
-// with the specified priority and categorized by error type.
+// with the specified priority and categorized by error type. The priority of the issue types
+// can be changed in each project.
 func (ds *IssueRepository) FindIssuesByTypeAndPriority(cid int64, p int) []models.IssueGroup {
 	issues := []models.IssueGroup{}
 	query := `
 		SELECT
 			issue_types.type,
-			issue_types.priority,
+			` + customIssueName + `,
+			MAX(` + issuePriority + `),
+			issue_types.category,
 			count(DISTINCT issues.pagereport_id) AS c
 		FROM issues
 		INNER JOIN  issue_types ON issue_types.id = issues.issue_type_id
-		WHERE crawl_id = ? AND issue_types.priority = ? GROUP BY issue_type_id
+		` + issueTypeOverridesJoin + `
+		WHERE issues.crawl_id = ? AND ` + issuePriority + ` = ? AND NOT ` + issueSuppressed + `
+		GROUP BY issues.issue_type_id
 		ORDER BY c DESC`
 
 	rows, err := ds.DB.Query(query, cid, p)
@@ -69,7 +107,7 @@ func (ds *IssueRepository) FindIssuesByTypeAndPriority(cid int64, p int) []model
 
 	for rows.Next() {
 		ig := models.IssueGroup{}
-		err := rows.Scan(&ig.ErrorType, &ig.Priority, &ig.Count)
+		err := rows.Scan(&ig.ErrorType, &ig.Name, &ig.Priority, &ig.Category, &ig.Count)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -82,21 +120,30 @@ func (ds *IssueRepository) FindIssuesByTypeAndPriority(cid int64, p int) []model
 }
 
 // FindPassedIssues returns an IssueGroup model with all the issues types that have passed
-// and don't have any reported issue for the specified crawl.
+// and don't have any reported issue for the specified crawl. Custom issue types are only
+// included if they belong to the crawl's project, and the issue types disabled in the
+// project are not included. Suppressed issues are not taken into account.
 func (ds *IssueRepository) FindPassedIssues(cid int64) []models.IssueGroup {
 	issues := []models.IssueGroup{}
 	query := `
 		SELECT
 			issue_types.type,
-			issue_types.priority,
+			` + customIssueName + `,
+			COALESCE(issue_type_overrides.priority, issue_types.priority),
+			issue_types.category,
 			count(DISTINCT issues.pagereport_id) AS c
 		FROM issue_types
 		LEFT JOIN  issues ON issue_types.id = issues.issue_type_id AND issues.crawl_id = ?
-		GROUP BY issue_types.id, issue_types.type, issue_types.priority
+			AND NOT ` + issueSuppressed + `
+		LEFT JOIN issue_type_overrides ON issue_type_overrides.issue_type_id = issue_types.id
+			AND issue_type_overrides.project_id = (SELECT project_id FROM crawls WHERE id = ?)
+		WHERE (issue_types.project_id IS NULL OR issue_types.project_id = (SELECT project_id FROM crawls WHERE id = ?))
+			AND COALESCE(issue_type_overrides.disabled, 0) = 0
+		GROUP BY issue_types.id, issue_types.type, issue_types.priority, issue_types.category, issue_type_overrides.priority
 		HAVING COUNT(issues.id) = 0
 		ORDER BY issue_types.type;`
 
-	rows, err := ds.DB.Query(query, cid)
+	rows, err := ds.DB.Query(query, cid, cid, cid)
 	if err != nil {
 		log.Println(err)
 		return issues
@@ -104,7 +151,7 @@ func (ds *IssueRepository) FindPassedIssues(cid int64) []models.IssueGroup {
 
 	for rows.Next() {
 		ig := models.IssueGroup{}
-		err := rows.Scan(&ig.ErrorType, &ig.Priority, &ig.Count)
+		err := rows.Scan(&ig.ErrorType, &ig.Name, &ig.Priority, &ig.Category, &ig.Count)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -117,14 +164,16 @@ func (ds *IssueRepository) FindPassedIssues(cid int64) []models.IssueGroup {
 }
 
 // CountIssuesByPriority returns the total number of issues of the specified priority
-// found in a crawl.
+// found in a crawl, considering the priority of the issue types in the crawl's project.
+// Suppressed issues are not counted.
 func (ds *IssueRepository) CountIssuesByPriority(cid int64, p int) int {
 	query := `
 		SELECT
 			count(issues.pagereport_id) AS c
 		FROM issues
 		INNER JOIN  issue_types ON issue_types.id = issues.issue_type_id
-		WHERE crawl_id = ? AND issue_types.priority = ? GROUP BY issue_types.priority`
+		` + issueTypeOverridesJoin + `
+		WHERE issues.crawl_id = ? AND ` + issuePriority + ` = ? AND NOT ` + issueSuppressed
 
 	row := ds.DB.QueryRow(query, cid, p)
 	var c int
@@ -137,12 +186,13 @@ func (ds *IssueRepository) CountIssuesByPriority(cid int64, p int) int {
 
 // GetNumberOfPagesForIssues returns the total number of pages for an specific issue "errorType". This can
 // be used in combination with FindPageReportIssues to generate a paginated view of the issues.
-func (ds *IssueRepository) GetNumberOfPagesForIssues(cid int64, errorType string) int {
+// If suppressed is true it only counts the suppressed issues, otherwise it counts the rest.
+func (ds *IssueRepository) GetNumberOfPagesForIssues(cid int64, errorType string, suppressed bool) int {
 	query := `
 		SELECT count(DISTINCT pagereport_id)
 		FROM issues
 		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-		WHERE issue_types.type = ? AND crawl_id  = ?`
+		WHERE issue_types.type = ? AND crawl_id  = ? AND ` + suppressedCondition(suppressed)
 
 	row := ds.DB.QueryRow(query, errorType, cid)
 	var c int
@@ -154,8 +204,9 @@ func (ds *IssueRepository) GetNumberOfPagesForIssues(cid int64, errorType string
 }
 
 // FindPageReportIssues returns a slice of PageReports corresponding to the page specified in the "p" parameter
-// and with the errorType specified in "errorType".
-func (ds *IssueRepository) FindPageReportIssues(cid int64, p int, errorType string) []models.PageReport {
+// and with the errorType specified in "errorType". If suppressed is true it only returns the page reports
+// with suppressed issues, otherwise it returns the rest.
+func (ds *IssueRepository) FindPageReportIssues(cid int64, p int, errorType string, suppressed bool) []models.PageReport {
 	max := paginationMax
 	offset := max * (p - 1)
 
@@ -169,7 +220,7 @@ func (ds *IssueRepository) FindPageReportIssues(cid int64, p int, errorType stri
 			SELECT DISTINCT pagereport_id
 			FROM issues
 			INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-			WHERE issue_types.type = ? AND crawl_id = ?
+			WHERE issue_types.type = ? AND crawl_id = ? AND ` + suppressedCondition(suppressed) + `
 		) ORDER BY url ASC LIMIT ?, ?`
 
 	var pageReports []models.PageReport
@@ -193,11 +244,12 @@ func (ds *IssueRepository) FindPageReportIssues(cid int64, p int, errorType stri
 }
 
 // Return the issue types found for an specific page report.
-func (ds *IssueRepository) FindErrorTypesByPage(pid int, cid int64) []string {
-	var et []string
+func (ds *IssueRepository) FindErrorTypesByPage(pid int, cid int64) []models.IssueGroup {
+	var et []models.IssueGroup
 	query := `
 		SELECT 
-			issue_types.type
+			issue_types.type,
+			` + customIssueName + `
 		FROM issues
 		INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
 		WHERE pagereport_id = ? and crawl_id = ?
@@ -210,14 +262,83 @@ func (ds *IssueRepository) FindErrorTypesByPage(pid int, cid int64) []string {
 	}
 
 	for rows.Next() {
-		var s string
-		err := rows.Scan(&s)
+		ig := models.IssueGroup{}
+		err := rows.Scan(&ig.ErrorType, &ig.Name)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
-		et = append(et, s)
+		et = append(et, ig)
 	}
 
 	return et
 }
+
+// FindCustomIssueName returns the name of a project's custom issue type. It returns an
+// empty string if the issue type is not a custom issue of the project.
+func (ds *IssueRepository) FindCustomIssueName(projectId int64, errorType string) string {
+	query := `
+		SELECT custom_issue_rules.name
+		FROM custom_issue_rules
+		INNER JOIN issue_types ON issue_types.id = custom_issue_rules.issue_type_id
+		WHERE issue_types.type = ? AND custom_issue_rules.project_id = ?`
+
+	var name string
+	row := ds.DB.QueryRow(query, errorType, projectId)
+	if err := row.Scan(&name); err != nil && err != sql.ErrNoRows {
+		log.Printf("FindCustomIssueName: %v\n", err)
+	}
+
+	return name
+}
+
+// FindNearDuplicates returns the pages in the crawl with a text similarity to the page report
+// equal or higher than minSimilarity, sorted by similarity. Pages with the exact same content
+// are not included.
+func (ds *IssueRepository) FindNearDuplicates(pid int64, cid int64, minSimilarity int) []models.NearDuplicate {
+	nearDuplicates := []models.NearDuplicate{}
+	query := `
+		SELECT
+			b.id,
+			b.url,
+			FLOOR((64 - BIT_COUNT(a.simhash ^ b.simhash)) * 100 / 64) AS similarity
+		FROM pagereports a
+		INNER JOIN pagereports b ON b.crawl_id = a.crawl_id AND b.id <> a.id
+		WHERE a.id = ? AND a.crawl_id = ? AND a.simhash <> 0 AND b.simhash <> 0
+			AND b.main_text_hash <> a.main_text_hash AND b.media_type = "text/html"
+			AND b.status_code >= 200 AND b.status_code < 300
+			AND (b.canonical = "" OR b.canonical = b.url) AND b.crawled = 1
+		HAVING similarity >= ?
+		ORDER BY similarity DESC, b.url
+		LIMIT ?`
+
+	rows, err := ds.DB.Query(query, pid, cid, minSimilarity, paginationMax)
+	if err != nil {
+		log.Println(err)
+		return nearDuplicates
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		d := models.NearDuplicate{}
+		err := rows.Scan(&d.Id, &d.URL, &d.Similarity)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		nearDuplicates = append(nearDuplicates, d)
+	}
+
+	return nearDuplicates
+}
+
+// suppressedCondition returns the condition to select the suppressed issues
+// or the ones that are not suppressed.
+func suppressedCondition(suppressed bool) string {
+	if suppressed {
+		return issueSuppressed
+	}
+
+	return "NOT " + issueSuppressed
+}
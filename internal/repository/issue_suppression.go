@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type IssueSuppressionRepository struct {
+	DB *sql.DB
+}
+
+// FindIssueSuppressions returns all the issue suppressions of a project with the
+// email of the user that created them.
+func (ds *IssueSuppressionRepository) FindIssueSuppressions(projectId int64) []models.IssueSuppression {
+	suppressions := []models.IssueSuppression{}
+
+	query := `
+		SELECT
+			issue_suppressions.id,
+			issue_suppressions.project_id,
+			issue_types.type,
+			` + customIssueName + `,
+			issue_suppressions.url,
+			issue_suppressions.pattern,
+			COALESCE(issue_suppressions.comment, ''),
+			COALESCE(issue_suppressions.user_id, 0),
+			COALESCE(users.email, ''),
+			issue_suppressions.created
+		FROM issue_suppressions
+		INNER JOIN issue_types ON issue_types.id = issue_suppressions.issue_type_id
+		LEFT JOIN users ON users.id = issue_suppressions.user_id
+		WHERE issue_suppressions.project_id = ?
+		ORDER BY issue_suppressions.created DESC`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return suppressions
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		s := models.IssueSuppression{}
+		err := rows.Scan(
+			&s.Id,
+			&s.ProjectId,
+			&s.ErrorType,
+			&s.IssueName,
+			&s.URL,
+			&s.Pattern,
+			&s.Comment,
+			&s.UserId,
+			&s.Author,
+			&s.Created,
+		)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		suppressions = append(suppressions, s)
+	}
+
+	return suppressions
+}
+
+// SaveIssueSuppression stores a new issue suppression and sets its id. The URL is stored
+// with its hash so it applies to the page in all the crawls. It returns sql.ErrNoRows if
+// the issue type doesn't exist in the project.
+func (ds *IssueSuppressionRepository) SaveIssueSuppression(s *models.IssueSuppression) error {
+	urlHash := ""
+	if s.URL != "" {
+		urlHash = Hash(s.URL)
+	}
+
+	likePattern := ""
+	if s.Pattern != "" {
+		likePattern = LikePattern(s.Pattern)
+	}
+
+	query := `
+		INSERT INTO issue_suppressions (project_id, issue_type_id, url, url_hash, pattern, like_pattern, comment, user_id)
+		SELECT ?, issue_types.id, ?, ?, ?, ?, ?, ?
+		FROM issue_types
+		WHERE issue_types.type = ? AND (issue_types.project_id IS NULL OR issue_types.project_id = ?)`
+
+	res, err := ds.DB.Exec(
+		query,
+		s.ProjectId,
+		Truncate(s.URL, 2048),
+		urlHash,
+		Truncate(s.Pattern, 2048),
+		likePattern,
+		s.Comment,
+		s.UserId,
+		s.ErrorType,
+		s.ProjectId,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	s.Id, err = res.LastInsertId()
+
+	return err
+}
+
+// DeleteIssueSuppression deletes a project's issue suppression.
+func (ds *IssueSuppressionRepository) DeleteIssueSuppression(id, projectId int64) error {
+	query := `DELETE FROM issue_suppressions WHERE id = ? AND project_id = ?`
+	_, err := ds.DB.Exec(query, id, projectId)
+
+	return err
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type IssueThresholdsRepository struct {
+	DB *sql.DB
+}
+
+// FindIssueThresholds returns the project's issue thresholds. It returns sql.ErrNoRows
+// if the project doesn't have its own thresholds.
+func (ds *IssueThresholdsRepository) FindIssueThresholds(projectId int64) (models.IssueThresholds, error) {
+	query := `
+		SELECT
+			project_id,
+			min_words,
+			max_dom_size,
+			min_title_length,
+			max_title_length,
+			min_description_length,
+			max_description_length,
+			max_ttfb,
+			max_image_size,
+			max_alt_text_length,
+			max_links,
+			min_similarity,
+			max_page_weight,
+			max_scripts_weight,
+			max_page_requests,
+			max_legacy_image_size
+		FROM issue_thresholds
+		WHERE project_id = ?`
+
+	t := models.IssueThresholds{}
+	row := ds.DB.QueryRow(query, projectId)
+	err := row.Scan(
+		&t.ProjectId,
+		&t.MinWords,
+		&t.MaxDOMSize,
+		&t.MinTitleLength,
+		&t.MaxTitleLength,
+		&t.MinDescriptionLength,
+		&t.MaxDescriptionLength,
+		&t.MaxTTFB,
+		&t.MaxImageSize,
+		&t.MaxAltTextLength,
+		&t.MaxLinks,
+		&t.MinSimilarity,
+		&t.MaxPageWeight,
+		&t.MaxScriptsWeight,
+		&t.MaxPageRequests,
+		&t.MaxLegacyImageSize,
+	)
+
+	return t, err
+}
+
+// SaveIssueThresholds stores the project's issue thresholds replacing the existing ones.
+func (ds *IssueThresholdsRepository) SaveIssueThresholds(t *models.IssueThresholds) error {
+	query := `
+		REPLACE INTO issue_thresholds (
+			project_id,
+			min_words,
+			max_dom_size,
+			min_title_length,
+			max_title_length,
+			min_description_length,
+			max_description_length,
+			max_ttfb,
+			max_image_size,
+			max_alt_text_length,
+			max_links,
+			min_similarity,
+			max_page_weight,
+			max_scripts_weight,
+			max_page_requests,
+			max_legacy_image_size
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	_, err := ds.DB.Exec(
+		query,
+		t.ProjectId,
+		t.MinWords,
+		t.MaxDOMSize,
+		t.MinTitleLength,
+		t.MaxTitleLength,
+		t.MinDescriptionLength,
+		t.MaxDescriptionLength,
+		t.MaxTTFB,
+		t.MaxImageSize,
+		t.MaxAltTextLength,
+		t.MaxLinks,
+		t.MinSimilarity,
+		t.MaxPageWeight,
+		t.MaxScriptsWeight,
+		t.MaxPageRequests,
+		t.MaxLegacyImageSize,
+	)
+
+	return err
+}
+
+// DeleteIssueThresholds removes the project's issue thresholds so the default ones are used.
+func (ds *IssueThresholdsRepository) DeleteIssueThresholds(projectId int64) error {
+	_, err := ds.DB.Exec("DELETE FROM issue_thresholds WHERE project_id = ?", projectId)
+
+	return err
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type IssueTypeSettingRepository struct {
+	DB *sql.DB
+}
+
+// FindIssueTypeSettings returns the settings of all the issue types available in a project,
+// including the project's custom issues. Issue types without overrides are returned with
+// their default priority.
+func (ds *IssueTypeSettingRepository) FindIssueTypeSettings(projectId int64) []models.IssueTypeSetting {
+	settings := []models.IssueTypeSetting{}
+
+	query := `
+		SELECT
+			issue_types.id,
+			issue_types.type,
+			` + customIssueName + `,
+			issue_types.priority,
+			COALESCE(issue_type_overrides.priority, issue_types.priority),
+			COALESCE(issue_type_overrides.disabled, 0)
+		FROM issue_types
+		LEFT JOIN issue_type_overrides ON issue_type_overrides.issue_type_id = issue_types.id
+			AND issue_type_overrides.project_id = ?
+		WHERE issue_types.project_id IS NULL OR issue_types.project_id = ?
+		ORDER BY issue_types.priority, issue_types.id`
+
+	rows, err := ds.DB.Query(query, projectId, projectId)
+	if err != nil {
+		log.Println(err)
+		return settings
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		s := models.IssueTypeSetting{}
+		err := rows.Scan(&s.IssueTypeId, &s.Type, &s.Name, &s.DefaultPriority, &s.Priority, &s.Disabled)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		settings = append(settings, s)
+	}
+
+	return settings
+}
+
+// SaveIssueTypeSettings replaces the project's issue type overrides. Only the settings that
+// disable the issue type or change its default priority are stored.
+func (ds *IssueTypeSettingRepository) SaveIssueTypeSettings(projectId int64, settings []models.IssueTypeSetting) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("DELETE FROM issue_type_overrides WHERE project_id = ?", projectId)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO issue_type_overrides (project_id, issue_type_id, priority, disabled)
+		VALUES (?, ?, ?, ?)`
+
+	for _, s := range settings {
+		if !s.Disabled && s.Priority == s.DefaultPriority {
+			continue
+		}
+
+		_, err = tx.Exec(query, projectId, s.IssueTypeId, s.Priority, s.Disabled)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// FindDisabledIssueTypes returns the ids of the issue types disabled in a project.
+func (ds *IssueTypeSettingRepository) FindDisabledIssueTypes(projectId int64) []int {
+	types := []int{}
+
+	query := `
+		SELECT issue_type_id
+		FROM issue_type_overrides
+		WHERE project_id = ? AND disabled = 1`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return types
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t int
+		err := rows.Scan(&t)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type IssueWorkflowRepository struct {
+	DB *sql.DB
+}
+
+// Condition that is true if the issue type has issues in the crawl that are not suppressed.
+// It requires the crawl id as a parameter.
+const issueTypeReported = `EXISTS (
+	SELECT issues.id
+	FROM issues
+	WHERE issues.crawl_id = ? AND issues.issue_type_id = issue_workflows.issue_type_id
+		AND NOT ` + issueSuppressed + `
+)`
+
+// FindIssueWorkflow returns the workflow of an issue type in a project. Issue types without
+// a workflow are returned as open. It returns sql.ErrNoRows if the issue type doesn't exist
+// in the project.
+func (ds *IssueWorkflowRepository) FindIssueWorkflow(projectId int64, errorType string) (models.IssueWorkflow, error) {
+	w := models.IssueWorkflow{
+		ProjectId: projectId,
+		ErrorType: errorType,
+	}
+
+	query := `
+		SELECT
+			COALESCE(issue_workflows.status, ?),
+			COALESCE(issue_workflows.assignee_id, 0),
+			COALESCE(users.email, ''),
+			issue_workflows.due_date
+		FROM issue_types
+		LEFT JOIN issue_workflows ON issue_workflows.issue_type_id = issue_types.id
+			AND issue_workflows.project_id = ?
+		LEFT JOIN users ON users.id = issue_workflows.assignee_id
+		WHERE issue_types.type = ? AND (issue_types.project_id IS NULL OR issue_types.project_id = ?)`
+
+	var dueDate sql.NullTime
+	row := ds.DB.QueryRow(query, models.IssueStatusOpen, projectId, errorType, projectId)
+	err := row.Scan(&w.Status, &w.AssigneeId, &w.Assignee, &dueDate)
+	if err != nil {
+		return w, err
+	}
+
+	if dueDate.Valid {
+		w.DueDate = dueDate.Time
+	}
+
+	return w, nil
+}
+
+// SaveIssueWorkflow inserts or updates the workflow of an issue type in a project.
+// It returns sql.ErrNoRows if the issue type doesn't exist in the project.
+func (ds *IssueWorkflowRepository) SaveIssueWorkflow(w *models.IssueWorkflow) error {
+	var assigneeId sql.NullInt64
+	if w.AssigneeId != 0 {
+		assigneeId = sql.NullInt64{Int64: int64(w.AssigneeId), Valid: true}
+	}
+
+	var dueDate sql.NullTime
+	if !w.DueDate.IsZero() {
+		dueDate = sql.NullTime{Time: w.DueDate, Valid: true}
+	}
+
+	query := `
+		INSERT INTO issue_workflows (project_id, issue_type_id, status, assignee_id, due_date)
+		SELECT ?, issue_types.id, ?, ?, ?
+		FROM issue_types
+		WHERE issue_types.type = ? AND (issue_types.project_id IS NULL OR issue_types.project_id = ?)
+		ON DUPLICATE KEY UPDATE
+			status = VALUES(status),
+			assignee_id = VALUES(assignee_id),
+			due_date = VALUES(due_date)`
+
+	res, err := ds.DB.Exec(query, w.ProjectId, w.Status, assigneeId, dueDate, w.ErrorType, w.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// Updating a row with its current values doesn't affect any row,
+	// so it is only an error if the issue type doesn't exist.
+	if affected == 0 {
+		_, err := ds.FindIssueWorkflow(w.ProjectId, w.ErrorType)
+		return err
+	}
+
+	return nil
+}
+
+// FindIssueComments returns the comments of an issue type's workflow in a project
+// sorted by creation date.
+func (ds *IssueWorkflowRepository) FindIssueComments(projectId int64, errorType string) []models.IssueComment {
+	comments := []models.IssueComment{}
+
+	query := `
+		SELECT
+			issue_comments.id,
+			issue_comments.project_id,
+			issue_types.type,
+			COALESCE(issue_comments.user_id, 0),
+			COALESCE(users.email, ''),
+			issue_comments.comment,
+			issue_comments.created
+		FROM issue_comments
+		INNER JOIN issue_types ON issue_types.id = issue_comments.issue_type_id
+		LEFT JOIN users ON users.id = issue_comments.user_id
+		WHERE issue_comments.project_id = ? AND issue_types.type = ?
+		ORDER BY issue_comments.created, issue_comments.id`
+
+	rows, err := ds.DB.Query(query, projectId, errorType)
+	if err != nil {
+		log.Println(err)
+		return comments
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		c := models.IssueComment{}
+		err := rows.Scan(&c.Id, &c.ProjectId, &c.ErrorType, &c.UserId, &c.Author, &c.Comment, &c.Created)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		comments = append(comments, c)
+	}
+
+	return comments
+}
+
+// SaveIssueComment adds a comment to an issue type's workflow and sets its id.
+// It returns sql.ErrNoRows if the issue type doesn't exist in the project.
+func (ds *IssueWorkflowRepository) SaveIssueComment(c *models.IssueComment) error {
+	query := `
+		INSERT INTO issue_comments (project_id, issue_type_id, user_id, comment)
+		SELECT ?, issue_types.id, ?, ?
+		FROM issue_types
+		WHERE issue_types.type = ? AND (issue_types.project_id IS NULL OR issue_types.project_id = ?)`
+
+	res, err := ds.DB.Exec(query, c.ProjectId, c.UserId, c.Comment, c.ErrorType, c.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	c.Id, err = res.LastInsertId()
+
+	return err
+}
+
+// FindProjectUsers returns the users that have access to a project.
+func (ds *IssueWorkflowRepository) FindProjectUsers(projectId int64) []models.User {
+	users := []models.User{}
+
+	query := `
+		SELECT users.id, users.email
+		FROM users
+		INNER JOIN projects ON projects.user_id = users.id
+		WHERE projects.id = ?
+		ORDER BY users.email`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return users
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		u := models.User{}
+		err := rows.Scan(&u.Id, &u.Email)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		users = append(users, u)
+	}
+
+	return users
+}
+
+// UpdateIssueWorkflows updates the status of the project's issue workflows once the crawl
+// has finished. Issue types that are no longer reported in the crawl are set as verified,
+// and verified issue types that are reported again are reopened. Issue types disabled in
+// the project are not checked by the crawl, so their status is not changed.
+func (ds *IssueWorkflowRepository) UpdateIssueWorkflows(crawl *models.Crawl) {
+	query := `
+		UPDATE issue_workflows
+		SET status = ?
+		WHERE project_id = ? AND status != ? AND NOT ` + issueTypeReported + `
+			AND NOT EXISTS (
+				SELECT issue_type_overrides.issue_type_id
+				FROM issue_type_overrides
+				WHERE issue_type_overrides.project_id = issue_workflows.project_id
+					AND issue_type_overrides.issue_type_id = issue_workflows.issue_type_id
+					AND issue_type_overrides.disabled = 1
+			)`
+
+	_, err := ds.DB.Exec(query, models.IssueStatusVerified, crawl.ProjectId, models.IssueStatusVerified, crawl.Id)
+	if err != nil {
+		log.Printf("UpdateIssueWorkflows: %v\n", err)
+	}
+
+	query = `
+		UPDATE issue_workflows
+		SET status = ?
+		WHERE project_id = ? AND status = ? AND ` + issueTypeReported
+
+	_, err = ds.DB.Exec(query, models.IssueStatusOpen, crawl.ProjectId, models.IssueStatusVerified, crawl.Id)
+	if err != nil {
+		log.Printf("UpdateIssueWorkflows: %v\n", err)
+	}
+}
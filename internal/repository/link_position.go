@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type LinkPositionRuleRepository struct {
+	DB *sql.DB
+}
+
+// FindLinkPositionRules returns the link position rules of a project in the order they were added.
+func (ds *LinkPositionRuleRepository) FindLinkPositionRules(projectId int64) []models.LinkPositionRule {
+	rules := []models.LinkPositionRule{}
+
+	query := `
+		SELECT id, project_id, xpath, position
+		FROM link_position_rules
+		WHERE project_id = ?
+		ORDER BY id`
+
+	rows, err := ds.DB.Query(query, projectId)
+	if err != nil {
+		log.Println(err)
+		return rules
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		r := models.LinkPositionRule{}
+		err := rows.Scan(&r.Id, &r.ProjectId, &r.XPath, &r.Position)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+// SaveLinkPositionRule stores a new link position rule in a project and sets its id.
+func (ds *LinkPositionRuleRepository) SaveLinkPositionRule(r *models.LinkPositionRule) error {
+	query := `INSERT INTO link_position_rules (project_id, xpath, position) VALUES (?, ?, ?)`
+
+	res, err := ds.DB.Exec(query, r.ProjectId, r.XPath, r.Position)
+	if err != nil {
+		return err
+	}
+
+	r.Id, err = res.LastInsertId()
+
+	return err
+}
+
+// DeleteLinkPositionRule deletes a project's link position rule.
+func (ds *LinkPositionRuleRepository) DeleteLinkPositionRule(id, projectId int64) error {
+	query := `DELETE FROM link_position_rules WHERE id = ? AND project_id = ?`
+	_, err := ds.DB.Exec(query, id, projectId)
+
+	return err
+}
+
+// linkPosition returns the link's position or the content position if it was not classified.
+func linkPosition(p string) string {
+	if p == "" {
+		return models.LinkPositionContent
+	}
+
+	return p
+}
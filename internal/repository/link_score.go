@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+	"math"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// highValuePages is the SQL condition for the indexable HTML pages included in the sitemap
+// that have a link score lower than a threshold.
+const highValuePages = `
+	crawl_id = ?
+	AND crawled = 1
+	AND in_sitemap = 1
+	AND media_type = "text/html"
+	AND status_code >= 200 AND status_code < 300
+	AND noindex = 0
+	AND (canonical = "" OR canonical = url)
+	AND link_score < ?`
+
+type LinkScoreRepository struct {
+	DB *sql.DB
+}
+
+// FindLinkGraph returns the internal link graph of a crawl. Links in pages with a nofollow
+// robots directive are marked as nofollow.
+func (ds *LinkScoreRepository) FindLinkGraph(crawlId int64) *models.LinkGraph {
+	graph := &models.LinkGraph{
+		Redirects:  make(map[int64]int64),
+		Canonicals: make(map[int64]int64),
+	}
+
+	query := `
+		SELECT
+			links.pagereport_id,
+			COALESCE(pagereports.id, 0),
+			(links.nofollow OR source.robots LIKE "%nofollow%" OR source.robots LIKE "%none%")
+		FROM links
+		INNER JOIN pagereports AS source ON source.id = links.pagereport_id
+		LEFT JOIN pagereports ON pagereports.url_hash = links.url_hash
+			AND pagereports.crawl_id = links.crawl_id
+			AND pagereports.crawled = 1
+		WHERE links.crawl_id = ?`
+
+	rows, err := ds.DB.Query(query, crawlId)
+	if err != nil {
+		log.Printf("FindLinkGraph: %v\n", err)
+		return graph
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		l := models.LinkGraphEdge{}
+		if err := rows.Scan(&l.From, &l.To, &l.NoFollow); err != nil {
+			log.Printf("FindLinkGraph: %v\n", err)
+			continue
+		}
+
+		graph.Links = append(graph.Links, l)
+	}
+
+	graph.Pages = ds.findIds(
+		"SELECT id FROM pagereports WHERE crawl_id = ? AND crawled = 1",
+		crawlId,
+	)
+
+	ds.findTransfers(graph.Redirects, `
+		SELECT a.id, b.id
+		FROM pagereports AS a
+		INNER JOIN pagereports AS b ON b.url_hash = a.redirect_hash AND b.crawl_id = a.crawl_id
+		WHERE a.crawl_id = ? AND a.redirect_hash != "" AND b.crawled = 1`,
+		crawlId,
+	)
+
+	ds.findTransfers(graph.Canonicals, `
+		SELECT a.id, b.id
+		FROM pagereports AS a
+		INNER JOIN pagereports AS b ON b.url_hash = SHA2(a.canonical, 256) AND b.crawl_id = a.crawl_id
+		WHERE a.crawl_id = ? AND a.canonical != "" AND a.canonical != a.url AND b.crawled = 1`,
+		crawlId,
+	)
+
+	return graph
+}
+
+// SaveLinkScores stores the link score of each one of the crawl's page reports.
+func (ds *LinkScoreRepository) SaveLinkScores(crawlId int64, scores map[int64]float64) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare("UPDATE pagereports SET link_score = ? WHERE id = ? AND crawl_id = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for id, score := range scores {
+		if _, err := stmt.Exec(score, id, crawlId); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetNumberOfPagesForLowLinkScore returns the number of pages in the paginator of
+// the high-value pages with a low link score.
+func (ds *LinkScoreRepository) GetNumberOfPagesForLowLinkScore(crawlId int64, threshold float64) int {
+	query := `SELECT count(id) FROM pagereports WHERE ` + highValuePages
+
+	row := ds.DB.QueryRow(query, crawlId, threshold)
+	var c int
+	if err := row.Scan(&c); err != nil {
+		log.Printf("GetNumberOfPagesForLowLinkScore: %v\n", err)
+	}
+	var f float64 = float64(c) / float64(paginationMax)
+	return int(math.Ceil(f))
+}
+
+// FindLowLinkScorePageReports returns a paginated slice with the high-value pages with a link
+// score lower than threshold, sorted by link score.
+func (ds *LinkScoreRepository) FindLowLinkScorePageReports(crawlId int64, threshold float64, p int) []models.PageReport {
+	max := paginationMax
+	offset := max * (p - 1)
+	pageReports := []models.PageReport{}
+
+	query := `
+		SELECT id, url, title, link_score, depth
+		FROM pagereports
+		WHERE ` + highValuePages + `
+		ORDER BY link_score ASC, url ASC
+		LIMIT ?, ?`
+
+	rows, err := ds.DB.Query(query, crawlId, threshold, offset, max)
+	if err != nil {
+		log.Printf("FindLowLinkScorePageReports: %v\n", err)
+		return pageReports
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.PageReport{}
+		if err := rows.Scan(&p.Id, &p.URL, &p.Title, &p.LinkScore, &p.Depth); err != nil {
+			log.Printf("FindLowLinkScorePageReports: %v\n", err)
+			continue
+		}
+
+		pageReports = append(pageReports, p)
+	}
+
+	return pageReports
+}
+
+// findIds returns the ids returned by the query.
+func (ds *LinkScoreRepository) findIds(query string, args ...interface{}) []int64 {
+	ids := []int64{}
+
+	rows, err := ds.DB.Query(query, args...)
+	if err != nil {
+		log.Printf("FindLinkGraph: %v\n", err)
+		return ids
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			log.Printf("FindLinkGraph: %v\n", err)
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// findTransfers adds the pairs of page report ids returned by the query to the transfers map.
+func (ds *LinkScoreRepository) findTransfers(transfers map[int64]int64, query string, args ...interface{}) {
+	rows, err := ds.DB.Query(query, args...)
+	if err != nil {
+		log.Printf("FindLinkGraph: %v\n", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var from, to int64
+		if err := rows.Scan(&from, &to); err != nil {
+			log.Printf("FindLinkGraph: %v\n", err)
+			continue
+		}
+
+		transfers[from] = to
+	}
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+	"math"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type PageWeightRepository struct {
+	DB *sql.DB
+}
+
+// FindPageWeightData returns the crawl's data needed to compute the weight of its HTML pages.
+// The size of the page reports is the transfer size, or the body size if the response was
+// not compressed.
+func (ds *PageWeightRepository) FindPageWeightData(crawlId int64) *models.PageWeightData {
+	d := &models.PageWeightData{
+		URLs:    make(map[string]int64),
+		Sizes:   make(map[int64]int64),
+		Scripts: make(map[int64][]string),
+		Styles:  make(map[int64][]string),
+		Images:  make(map[int64][]string),
+		Fonts:   make(map[int64][]string),
+	}
+
+	query := `
+		SELECT id, url, IF(transfer_size > 0, transfer_size, size), media_type = "text/html" AND status_code >= 200 AND status_code < 300
+		FROM pagereports
+		WHERE crawl_id = ? AND crawled = 1`
+
+	rows, err := ds.DB.Query(query, crawlId)
+	if err != nil {
+		log.Printf("FindPageWeightData: %v\n", err)
+		return d
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, size int64
+		var u string
+		var html bool
+		if err := rows.Scan(&id, &u, &size, &html); err != nil {
+			log.Printf("FindPageWeightData: %v\n", err)
+			continue
+		}
+
+		d.URLs[u] = id
+		d.Sizes[id] = size
+		if html {
+			d.Pages = append(d.Pages, id)
+		}
+	}
+
+	// The srcset candidates are not included as the browser only loads one of them.
+	ds.findResources(d.Scripts, "SELECT pagereport_id, url FROM scripts WHERE crawl_id = ?", crawlId)
+	ds.findResources(d.Styles, "SELECT pagereport_id, url FROM styles WHERE crawl_id = ?", crawlId)
+	ds.findResources(d.Images, "SELECT pagereport_id, url FROM images WHERE crawl_id = ? AND srcset = 0", crawlId)
+	ds.findResources(d.Fonts, "SELECT pagereport_id, url FROM fonts WHERE crawl_id = ?", crawlId)
+
+	return d
+}
+
+// SavePageWeights stores the weight of the crawl's HTML pages.
+func (ds *PageWeightRepository) SavePageWeights(crawlId int64, weights []models.PageWeight) error {
+	tx, err := ds.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO page_weights (
+			pagereport_id,
+			crawl_id,
+			document,
+			scripts,
+			styles,
+			images,
+			fonts,
+			total,
+			scripts_count,
+			styles_count,
+			images_count,
+			fonts_count
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, w := range weights {
+		_, err := stmt.Exec(
+			w.PageReportId,
+			crawlId,
+			w.Document,
+			w.Scripts,
+			w.Styles,
+			w.Images,
+			w.Fonts,
+			w.Total,
+			w.ScriptsCount,
+			w.StylesCount,
+			w.ImagesCount,
+			w.FontsCount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetNumberOfPagesForPageWeights returns the number of pages in the paginator of the
+// crawl's page weights.
+func (ds *PageWeightRepository) GetNumberOfPagesForPageWeights(crawlId int64) int {
+	row := ds.DB.QueryRow("SELECT count(id) FROM page_weights WHERE crawl_id = ?", crawlId)
+	var c int
+	if err := row.Scan(&c); err != nil {
+		log.Printf("GetNumberOfPagesForPageWeights: %v\n", err)
+	}
+	var f float64 = float64(c) / float64(paginationMax)
+	return int(math.Ceil(f))
+}
+
+// FindHeaviestPages returns a paginated slice with the crawl's page weights sorted by their
+// total weight.
+func (ds *PageWeightRepository) FindHeaviestPages(crawlId int64, p int) []models.PageWeight {
+	max := paginationMax
+	offset := max * (p - 1)
+	weights := []models.PageWeight{}
+
+	query := `
+		SELECT
+			page_weights.pagereport_id,
+			pagereports.url,
+			pagereports.title,
+			page_weights.document,
+			page_weights.scripts,
+			page_weights.styles,
+			page_weights.images,
+			page_weights.fonts,
+			page_weights.total,
+			page_weights.scripts_count,
+			page_weights.styles_count,
+			page_weights.images_count,
+			page_weights.fonts_count
+		FROM page_weights
+		INNER JOIN pagereports ON pagereports.id = page_weights.pagereport_id
+		WHERE page_weights.crawl_id = ?
+		ORDER BY page_weights.total DESC, pagereports.url ASC
+		LIMIT ?, ?`
+
+	rows, err := ds.DB.Query(query, crawlId, offset, max)
+	if err != nil {
+		log.Printf("FindHeaviestPages: %v\n", err)
+		return weights
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		w := models.PageWeight{}
+		err := rows.Scan(
+			&w.PageReportId,
+			&w.URL,
+			&w.Title,
+			&w.Document,
+			&w.Scripts,
+			&w.Styles,
+			&w.Images,
+			&w.Fonts,
+			&w.Total,
+			&w.ScriptsCount,
+			&w.StylesCount,
+			&w.ImagesCount,
+			&w.FontsCount,
+		)
+		if err != nil {
+			log.Printf("FindHeaviestPages: %v\n", err)
+			continue
+		}
+
+		weights = append(weights, w)
+	}
+
+	return weights
+}
+
+// findResources adds the page report ids and resource URLs returned by the query to the
+// resources map using the page report id as key.
+func (ds *PageWeightRepository) findResources(resources map[int64][]string, query string, crawlId int64) {
+	rows, err := ds.DB.Query(query, crawlId)
+	if err != nil {
+		log.Printf("FindPageWeightData: %v\n", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var u string
+		if err := rows.Scan(&id, &u); err != nil {
+			log.Printf("FindPageWeightData: %v\n", err)
+			continue
+		}
+
+		resources[id] = append(resources[id], u)
+	}
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"log"
 	"math"
 	"net/url"
+	"strings"
 
 	"github.com/stjudewashere/seonaut/internal/models"
 )
@@ -44,14 +47,21 @@ func (ds *PageReportRepository) SavePageReport(r *models.PageReport, cid int64)
 			h2,
 			words,
 			size,
+			transfer_size,
+			image_width,
+			image_height,
 			robotstxt_blocked,
 			crawled,
 			in_sitemap,
 			depth,
 			body_hash,
-			ttfb
+			ttfb,
+			simhash,
+			main_words,
+			main_text_hash,
+			main_text_excerpt
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	stmt, err := ds.DB.Prepare(query)
 	if err != nil {
@@ -80,12 +90,19 @@ func (ds *PageReportRepository) SavePageReport(r *models.PageReport, cid int64)
 		Truncate(r.H2, 1024),
 		r.Words,
 		r.Size,
+		r.TransferSize,
+		r.ImageWidth,
+		r.ImageHeight,
 		r.BlockedByRobotstxt,
 		r.Crawled,
 		r.InSitemap,
 		r.Depth,
 		r.BodyHash,
 		r.TTFB,
+		r.SimHash,
+		r.MainWords,
+		r.MainTextHash,
+		Truncate(r.MainTextExcerpt, 512),
 	)
 	if err != nil {
 		return r, err
@@ -106,6 +123,12 @@ func (ds *PageReportRepository) SavePageReport(r *models.PageReport, cid int64)
 		ds.SavePageReportVideos,
 		ds.SavePageReportScripts,
 		ds.SavePageReportStyles,
+		ds.SavePageReportFonts,
+		ds.SavePageReportStructuredData,
+		ds.SavePageReportSocialTags,
+		ds.SavePageReportExtractions,
+		ds.SavePageReportMixedContent,
+		ds.SavePageReportLoadingFindings,
 	}
 
 	for _, sf := range f {
@@ -124,12 +147,12 @@ func (ds *PageReportRepository) SavePageReportLinks(r *models.PageReport, cid in
 		return nil
 	}
 
-	sqlString := "INSERT INTO links (pagereport_id, crawl_id, url, scheme, rel, nofollow, text, url_hash) values "
+	sqlString := "INSERT INTO links (pagereport_id, crawl_id, url, scheme, rel, nofollow, text, url_hash, position) values "
 	v := []interface{}{}
 	for _, l := range r.Links {
 		hash := Hash(l.URL)
-		sqlString += "(?, ?, ?, ?, ?, ?, ?, ?),"
-		v = append(v, r.Id, cid, l.URL, l.ParsedURL.Scheme, l.Rel, l.NoFollow, Truncate(l.Text, 1024), hash)
+		sqlString += "(?, ?, ?, ?, ?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, l.URL, l.ParsedURL.Scheme, l.Rel, l.NoFollow, Truncate(l.Text, 1024), hash, linkPosition(l.Position))
 	}
 	sqlString = sqlString[0 : len(sqlString)-1]
 	stmt, err := ds.DB.Prepare(sqlString)
@@ -148,11 +171,11 @@ func (ds *PageReportRepository) SavePageReportExternalLinks(r *models.PageReport
 		return nil
 	}
 
-	sqlString := "INSERT INTO external_links (pagereport_id, crawl_id, url, rel, nofollow, text, sponsored, ugc, status_code) values "
+	sqlString := "INSERT INTO external_links (pagereport_id, crawl_id, url, rel, nofollow, text, sponsored, ugc, status_code, position) values "
 	v := []interface{}{}
 	for _, l := range r.ExternalLinks {
-		sqlString += "(?, ?, ?, ?, ?, ?, ?, ?, ?),"
-		v = append(v, r.Id, cid, l.URL, l.Rel, l.NoFollow, Truncate(l.Text, 1024), l.Sponsored, l.UGC, l.StatusCode)
+		sqlString += "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, l.URL, l.Rel, l.NoFollow, Truncate(l.Text, 1024), l.Sponsored, l.UGC, l.StatusCode, linkPosition(l.Position))
 	}
 	sqlString = sqlString[0 : len(sqlString)-1]
 	stmt, err := ds.DB.Prepare(sqlString)
@@ -191,11 +214,11 @@ func (ds *PageReportRepository) SavePageReportImages(r *models.PageReport, cid i
 		return nil
 	}
 
-	sqlString := "INSERT INTO images (pagereport_id, url, alt, crawl_id) values "
+	sqlString := "INSERT INTO images (pagereport_id, url, alt, width, height, srcset, crawl_id) values "
 	v := []interface{}{}
 	for _, i := range r.Images {
-		sqlString += "(?, ?, ?, ?),"
-		v = append(v, r.Id, i.URL, Truncate(i.Alt, 1024), cid)
+		sqlString += "(?, ?, ?, ?, ?, ?, ?),"
+		v = append(v, r.Id, i.URL, Truncate(i.Alt, 1024), i.Width, i.Height, i.Srcset, cid)
 	}
 	sqlString = sqlString[0 : len(sqlString)-1]
 	stmt, _ := ds.DB.Prepare(sqlString)
@@ -225,6 +248,54 @@ func (ds *PageReportRepository) SavePageReportIframes(r *models.PageReport, cid
 	return err
 }
 
+// Save pagereport mixed content.
+func (ds *PageReportRepository) SavePageReportMixedContent(r *models.PageReport, cid int64) error {
+	if len(r.MixedContent) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO mixed_content (pagereport_id, crawl_id, url, type, element) values "
+
+	v := []interface{}{}
+	for _, m := range r.MixedContent {
+		sqlString += "(?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, Truncate(m.URL, 2048), m.Type, m.Element)
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, err := ds.DB.Prepare(sqlString)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(v...)
+	return err
+}
+
+// Save pagereport loading findings.
+func (ds *PageReportRepository) SavePageReportLoadingFindings(r *models.PageReport, cid int64) error {
+	if len(r.LoadingFindings) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO loading_findings (pagereport_id, crawl_id, url, type, element) values "
+
+	v := []interface{}{}
+	for _, f := range r.LoadingFindings {
+		sqlString += "(?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, Truncate(f.URL, 2048), f.Type, f.Element)
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, err := ds.DB.Prepare(sqlString)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(v...)
+	return err
+}
+
 // Save pagereport audios.
 func (ds *PageReportRepository) SavePageReportAudios(r *models.PageReport, cid int64) error {
 	if len(r.Audios) == 0 {
@@ -309,6 +380,100 @@ func (ds *PageReportRepository) SavePageReportStyles(r *models.PageReport, cid i
 	return err
 }
 
+// Save pagereport fonts.
+func (ds *PageReportRepository) SavePageReportFonts(r *models.PageReport, cid int64) error {
+	if len(r.Fonts) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO fonts (pagereport_id, url, crawl_id) values "
+	v := []interface{}{}
+	for _, f := range r.Fonts {
+		sqlString += "(?, ?, ?),"
+		v = append(v, r.Id, f, cid)
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, _ := ds.DB.Prepare(sqlString)
+	defer stmt.Close()
+
+	_, err := stmt.Exec(v...)
+	return err
+}
+
+// Save pagereport structured data entities. The entity properties are stored as JSON.
+func (ds *PageReportRepository) SavePageReportStructuredData(r *models.PageReport, cid int64) error {
+	if len(r.StructuredData) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO structured_data (pagereport_id, crawl_id, format, type, properties, missing, error) values "
+	v := []interface{}{}
+	for _, sd := range r.StructuredData {
+		properties, err := json.Marshal(sd.Properties)
+		if err != nil {
+			log.Println(err)
+		}
+
+		sqlString += "(?, ?, ?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, sd.Format, Truncate(sd.Type, 256), string(properties), Truncate(strings.Join(sd.Missing, ", "), 1024), Truncate(sd.Error, 1024))
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, err := ds.DB.Prepare(sqlString)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(v...)
+	return err
+}
+
+// Save pagereport Open Graph and Twitter Card tags.
+func (ds *PageReportRepository) SavePageReportSocialTags(r *models.PageReport, cid int64) error {
+	if len(r.SocialTags) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO social_tags (pagereport_id, crawl_id, property, content, content_hash) values "
+	v := []interface{}{}
+	for _, t := range r.SocialTags {
+		sqlString += "(?, ?, ?, ?, ?),"
+		v = append(v, r.Id, cid, Truncate(t.Property, 256), Truncate(t.Content, 2048), ResolvedHash(r.ParsedURL, t.Content))
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, err := ds.DB.Prepare(sqlString)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(v...)
+	return err
+}
+
+// Save pagereport values extracted with the project's extraction rules.
+func (ds *PageReportRepository) SavePageReportExtractions(r *models.PageReport, cid int64) error {
+	if len(r.Extractions) == 0 {
+		return nil
+	}
+
+	sqlString := "INSERT INTO extractions (pagereport_id, crawl_id, rule_id, value) values "
+	v := []interface{}{}
+	for _, e := range r.Extractions {
+		sqlString += "(?, ?, ?, ?),"
+		v = append(v, r.Id, cid, e.RuleId, Truncate(e.Value, 16000))
+	}
+	sqlString = sqlString[0 : len(sqlString)-1]
+	stmt, err := ds.DB.Prepare(sqlString)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(v...)
+	return err
+}
+
 // FindAllPageReportsByCrawlId returns a channel where it streams all the crawl's page reports.
 // Once it is done it closes the channel.
 func (ds *PageReportRepository) FindAllPageReportsByCrawlId(cid int64) <-chan *models.PageReport {
@@ -336,12 +501,19 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlId(cid int64) <-chan *m
 				h2,
 				words,
 				size,
+				transfer_size,
+				image_width,
+				image_height,
 				robotstxt_blocked,
 				crawled,
 				in_sitemap,
 				depth,
 				body_hash,
-				ttfb
+				ttfb,
+				main_words,
+				main_text_excerpt,
+				link_score,
+				click_depth
 			FROM pagereports
 			WHERE crawl_id = ?`
 
@@ -369,12 +541,19 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlId(cid int64) <-chan *m
 				&p.H2,
 				&p.Words,
 				&p.Size,
+				&p.TransferSize,
+				&p.ImageWidth,
+				&p.ImageHeight,
 				&p.BlockedByRobotstxt,
 				&p.Crawled,
 				&p.InSitemap,
 				&p.Depth,
 				&p.BodyHash,
 				&p.TTFB,
+				&p.MainWords,
+				&p.MainTextExcerpt,
+				&p.LinkScore,
+				&p.ClickDepth,
 			)
 			if err != nil {
 				log.Println(err)
@@ -389,7 +568,7 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlId(cid int64) <-chan *m
 }
 
 // FindAllPageReportsByCrawlIdAndErrorType returns a channel of pagereports where it streams all the reports
-// for the specified crawl and error type. Once it is done it closes the channel.
+// for the specified crawl and error type, excluding the suppressed issues. Once it is done it closes the channel.
 func (ds *PageReportRepository) FindAllPageReportsByCrawlIdAndErrorType(cid int64, et string) <-chan *models.PageReport {
 	prStream := make(chan *models.PageReport)
 
@@ -415,12 +594,19 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlIdAndErrorType(cid int6
 				h2,
 				words,
 				size,
+				transfer_size,
+				image_width,
+				image_height,
 				robotstxt_blocked,
 				crawled,
 				in_sitemap,
 				depth,
 				body_hash,
-				ttfb
+				ttfb,
+				main_words,
+				main_text_excerpt,
+				link_score,
+				click_depth
 			FROM pagereports
 			WHERE crawl_id = ?
 			AND id IN (
@@ -428,7 +614,7 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlIdAndErrorType(cid int6
 					pagereport_id
 				FROM issues
 				INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
-				WHERE issue_types.type = ? AND crawl_id = ?
+				WHERE issue_types.type = ? AND crawl_id = ? AND NOT ` + issueSuppressed + `
 			)`
 
 		rows, err := ds.DB.Query(query, cid, et, cid)
@@ -455,12 +641,19 @@ func (ds *PageReportRepository) FindAllPageReportsByCrawlIdAndErrorType(cid int6
 				&p.H2,
 				&p.Words,
 				&p.Size,
+				&p.TransferSize,
+				&p.ImageWidth,
+				&p.ImageHeight,
 				&p.BlockedByRobotstxt,
 				&p.Crawled,
 				&p.InSitemap,
 				&p.Depth,
 				&p.BodyHash,
 				&p.TTFB,
+				&p.MainWords,
+				&p.MainTextExcerpt,
+				&p.LinkScore,
+				&p.ClickDepth,
 			)
 			if err != nil {
 				log.Println(err)
@@ -496,12 +689,19 @@ func (ds *PageReportRepository) FindPageReportById(rid int) models.PageReport {
 			h2,
 			words,
 			size,
+			transfer_size,
+			image_width,
+			image_height,
 			robotstxt_blocked,
 			crawled,
 			in_sitemap,
 			depth,
 			body_hash,
-			ttfb
+			ttfb,
+			main_words,
+			main_text_excerpt,
+			link_score,
+			click_depth
 		FROM pagereports
 		WHERE id = ?`
 
@@ -526,12 +726,19 @@ func (ds *PageReportRepository) FindPageReportById(rid int) models.PageReport {
 		&p.H2,
 		&p.Words,
 		&p.Size,
+		&p.TransferSize,
+		&p.ImageWidth,
+		&p.ImageHeight,
 		&p.BlockedByRobotstxt,
 		&p.Crawled,
 		&p.InSitemap,
 		&p.Depth,
 		&p.BodyHash,
 		&p.TTFB,
+		&p.MainWords,
+		&p.MainTextExcerpt,
+		&p.LinkScore,
+		&p.ClickDepth,
 	)
 	if err != nil {
 		log.Println(err)
@@ -569,22 +776,68 @@ func (ds *PageReportRepository) FindPageReportHreflangs(pageReport *models.PageR
 }
 
 // Find images in an specific pagereport.
+// The data of the crawled image resources is included, as well as the image issues
+// reported in the image resources.
 func (ds *PageReportRepository) FindPageReportImages(pageReport *models.PageReport, cid int64) []models.Image {
 	images := []models.Image{}
 
-	irows, err := ds.DB.Query("SELECT url, alt FROM images WHERE pagereport_id = ?", pageReport.Id)
+	query := `
+		SELECT
+			images.url,
+			images.alt,
+			images.width,
+			images.height,
+			images.srcset,
+			COALESCE(pagereports.image_width, 0),
+			COALESCE(pagereports.image_height, 0),
+			COALESCE(pagereports.status_code, 0),
+			COALESCE(pagereports.media_type, ""),
+			COALESCE(pagereports.size, 0),
+			COALESCE((
+				SELECT GROUP_CONCAT(issue_types.type ORDER BY issue_types.id)
+				FROM issues
+				INNER JOIN issue_types ON issue_types.id = issues.issue_type_id
+				WHERE issues.pagereport_id = pagereports.id
+				AND issue_types.type IN ("ERROR_LARGE_IMAGE", "ERROR_LEGACY_IMAGE_FORMAT")
+			), "")
+		FROM images
+		LEFT JOIN pagereports ON pagereports.url_hash = SHA2(images.url, 256)
+			AND pagereports.crawl_id = images.crawl_id
+		WHERE images.pagereport_id = ?
+		ORDER BY images.id`
+
+	irows, err := ds.DB.Query(query, pageReport.Id)
 	if err != nil {
 		log.Println(err)
+		return images
 	}
+	defer irows.Close()
 
 	for irows.Next() {
 		i := models.Image{}
-		err = irows.Scan(&i.URL, &i.Alt)
+		var issues string
+		err = irows.Scan(
+			&i.URL,
+			&i.Alt,
+			&i.Width,
+			&i.Height,
+			&i.Srcset,
+			&i.IntrinsicWidth,
+			&i.IntrinsicHeight,
+			&i.StatusCode,
+			&i.MediaType,
+			&i.Size,
+			&issues,
+		)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
 
+		if issues != "" {
+			i.Issues = strings.Split(issues, ",")
+		}
+
 		images = append(images, i)
 	}
 
@@ -614,6 +867,68 @@ func (ds *PageReportRepository) FindPageReportIframes(pageReport *models.PageRep
 	return iframes
 }
 
+// Find the mixed content in an specific pagereport, active mixed content first.
+func (ds *PageReportRepository) FindPageReportMixedContent(pageReport *models.PageReport, cid int64) []models.MixedContent {
+	mixedContent := []models.MixedContent{}
+
+	query := `
+		SELECT url, type, element
+		FROM mixed_content
+		WHERE pagereport_id = ? AND crawl_id = ?
+		ORDER BY type ASC, id ASC`
+
+	rows, err := ds.DB.Query(query, pageReport.Id, cid)
+	if err != nil {
+		log.Println(err)
+		return mixedContent
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m := models.MixedContent{}
+		err = rows.Scan(&m.URL, &m.Type, &m.Element)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		mixedContent = append(mixedContent, m)
+	}
+
+	return mixedContent
+}
+
+// Find the loading findings in an specific pagereport sorted by type.
+func (ds *PageReportRepository) FindPageReportLoadingFindings(pageReport *models.PageReport, cid int64) []models.LoadingFinding {
+	findings := []models.LoadingFinding{}
+
+	query := `
+		SELECT url, type, element
+		FROM loading_findings
+		WHERE pagereport_id = ? AND crawl_id = ?
+		ORDER BY type ASC, id ASC`
+
+	rows, err := ds.DB.Query(query, pageReport.Id, cid)
+	if err != nil {
+		log.Println(err)
+		return findings
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		f := models.LoadingFinding{}
+		err = rows.Scan(&f.URL, &f.Type, &f.Element)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		findings = append(findings, f)
+	}
+
+	return findings
+}
+
 // Find audios in an specific pagereport.
 func (ds *PageReportRepository) FindPageReportAudios(pageReport *models.PageReport, cid int64) []string {
 	audios := []string{}
@@ -706,6 +1021,135 @@ func (ds *PageReportRepository) FindPageReportStyles(pageReport *models.PageRepo
 	return styles
 }
 
+// Find the structured data entities of an specific pagereport.
+func (ds *PageReportRepository) FindPageReportStructuredData(pageReport *models.PageReport, cid int64) []models.StructuredData {
+	entities := []models.StructuredData{}
+
+	rows, err := ds.DB.Query("SELECT format, type, properties, missing, error FROM structured_data WHERE pagereport_id = ?", pageReport.Id)
+	if err != nil {
+		log.Println(err)
+		return entities
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sd models.StructuredData
+		var properties, missing string
+		err = rows.Scan(&sd.Format, &sd.Type, &properties, &missing, &sd.Error)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		decodeStructuredData(&sd, properties, missing)
+		entities = append(entities, sd)
+	}
+
+	return entities
+}
+
+// Find the Open Graph and Twitter Card tags of an specific pagereport.
+func (ds *PageReportRepository) FindPageReportSocialTags(pageReport *models.PageReport, cid int64) []models.SocialTag {
+	tags := []models.SocialTag{}
+
+	rows, err := ds.DB.Query("SELECT property, content FROM social_tags WHERE pagereport_id = ? ORDER BY id", pageReport.Id)
+	if err != nil {
+		log.Println(err)
+		return tags
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t models.SocialTag
+		err = rows.Scan(&t.Property, &t.Content)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		tags = append(tags, t)
+	}
+
+	return tags
+}
+
+// Find the extracted values of an specific pagereport.
+func (ds *PageReportRepository) FindPageReportExtractions(pageReport *models.PageReport, cid int64) []models.Extraction {
+	extractions := []models.Extraction{}
+
+	query := `
+		SELECT extractions.rule_id, extraction_rules.name, extractions.value
+		FROM extractions
+		INNER JOIN extraction_rules ON extraction_rules.id = extractions.rule_id
+		WHERE extractions.pagereport_id = ?
+		ORDER BY extraction_rules.id`
+
+	rows, err := ds.DB.Query(query, pageReport.Id)
+	if err != nil {
+		log.Println(err)
+		return extractions
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e models.Extraction
+		err = rows.Scan(&e.RuleId, &e.Name, &e.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		extractions = append(extractions, e)
+	}
+
+	return extractions
+}
+
+// FindPageReportsExtractions returns the extracted values of the page reports with the
+// specified ids in a map using the page report id as key. All the values are loaded with
+// a single query.
+func (ds *PageReportRepository) FindPageReportsExtractions(ids []int64, cid int64) map[int64][]models.Extraction {
+	extractions := make(map[int64][]models.Extraction)
+	if len(ids) == 0 {
+		return extractions
+	}
+
+	placeholders := []string{}
+	args := []interface{}{cid}
+	for _, id := range ids {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT extractions.pagereport_id, extractions.rule_id, extraction_rules.name, extractions.value
+		FROM extractions
+		INNER JOIN extraction_rules ON extraction_rules.id = extractions.rule_id
+		WHERE extractions.crawl_id = ? AND extractions.pagereport_id IN (%s)
+		ORDER BY extraction_rules.id`, strings.Join(placeholders, ","))
+
+	rows, err := ds.DB.Query(query, args...)
+	if err != nil {
+		log.Println(err)
+		return extractions
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var e models.Extraction
+		err = rows.Scan(&id, &e.RuleId, &e.Name, &e.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		extractions[id] = append(extractions[id], e)
+	}
+
+	return extractions
+}
+
 // FindLinks returns a slice of paginated InternalLinks. The page is specified in the "p" parameter.
 func (ds *PageReportRepository) FindLinks(pageReport *models.PageReport, cid int64, p int) []models.InternalLink {
 	max := paginationMax
@@ -721,7 +1165,8 @@ func (ds *PageReportRepository) FindLinks(pageReport *models.PageReport, cid int
 			links.url,
 			links.rel,
 			links.nofollow,
-			links.text
+			links.text,
+			links.position
 		FROM links
 		LEFT JOIN pagereports ON links.url_hash = pagereports.url_hash
 		WHERE links.pagereport_id = ? and pagereports.crawl_id = ?
@@ -744,6 +1189,7 @@ func (ds *PageReportRepository) FindLinks(pageReport *models.PageReport, cid int
 			&l.Link.Rel,
 			&l.Link.NoFollow,
 			&l.Link.Text,
+			&l.Link.Position,
 		)
 		if err != nil {
 			log.Println(err)
@@ -771,7 +1217,8 @@ func (ds *PageReportRepository) FindExternalLinks(pageReport *models.PageReport,
 			text,
 			sponsored,
 			ugc,
-			status_code
+			status_code,
+			position
 		FROM external_links
 		WHERE pagereport_id = ?
 		LIMIT ?,?
@@ -784,7 +1231,7 @@ func (ds *PageReportRepository) FindExternalLinks(pageReport *models.PageReport,
 
 	for lrows.Next() {
 		l := models.Link{}
-		err = lrows.Scan(&l.URL, &l.Rel, &l.NoFollow, &l.Text, &l.Sponsored, &l.UGC, &l.StatusCode)
+		err = lrows.Scan(&l.URL, &l.Rel, &l.NoFollow, &l.Text, &l.Sponsored, &l.UGC, &l.StatusCode, &l.Position)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -845,6 +1292,7 @@ func (ds *PageReportRepository) FindPaginatedPageReports(cid int64, p int, term
 			id,
 			url,
 			title,
+			link_score,
 			(CASE WHEN url = ? THEN 1 ELSE 0 END) AS exact_match
 		FROM pagereports
 		WHERE crawl_id = ?
@@ -870,7 +1318,7 @@ func (ds *PageReportRepository) FindPaginatedPageReports(cid int64, p int, term
 	for rows.Next() {
 		var e bool
 		p := models.PageReport{}
-		err := rows.Scan(&p.Id, &p.URL, &p.Title, &e)
+		err := rows.Scan(&p.Id, &p.URL, &p.Title, &p.LinkScore, &e)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -919,7 +1367,8 @@ func (ds *PageReportRepository) FindInLinks(s string, cid int64, p int) []models
 			pagereports.url,
 			pagereports.title,
 			links.nofollow,
-			links.text
+			links.text,
+			links.position
 		FROM links
 		LEFT JOIN pagereports ON pagereports.id = links.pagereport_id
 		WHERE links.url_hash = ? AND pagereports.crawl_id = ? AND pagereports.crawled = 1
@@ -933,7 +1382,7 @@ func (ds *PageReportRepository) FindInLinks(s string, cid int64, p int) []models
 
 	for rows.Next() {
 		il := models.InternalLink{}
-		err := rows.Scan(&il.PageReport.Id, &il.PageReport.URL, &il.PageReport.Title, &il.Link.NoFollow, &il.Link.Text)
+		err := rows.Scan(&il.PageReport.Id, &il.PageReport.URL, &il.PageReport.Title, &il.Link.NoFollow, &il.Link.Text, &il.Link.Position)
 		if err != nil {
 			log.Println(err)
 			continue
@@ -945,6 +1394,80 @@ func (ds *PageReportRepository) FindInLinks(s string, cid int64, p int) []models
 	return internalLinks
 }
 
+// FindClickPath returns the pages in the shortest click path from the start URL to
+// the specified page report, including both of them.
+func (ds *PageReportRepository) FindClickPath(pageReport *models.PageReport, cid int64) []models.PageReport {
+	pageReports := []models.PageReport{}
+
+	query := `
+		WITH RECURSIVE click_path (id, url, title, click_parent_id, step) AS (
+			SELECT id, url, title, click_parent_id, 0
+			FROM pagereports
+			WHERE id = ? AND crawl_id = ? AND click_depth >= 0
+			UNION ALL
+			SELECT pagereports.id, pagereports.url, pagereports.title, pagereports.click_parent_id, click_path.step + 1
+			FROM pagereports
+			INNER JOIN click_path ON pagereports.id = click_path.click_parent_id
+			WHERE pagereports.crawl_id = ?
+		)
+		SELECT id, url, title
+		FROM click_path
+		ORDER BY step DESC`
+
+	rows, err := ds.DB.Query(query, pageReport.Id, cid, cid)
+	if err != nil {
+		log.Printf("FindClickPath: %v\n", err)
+		return pageReports
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.PageReport{}
+		if err := rows.Scan(&p.Id, &p.URL, &p.Title); err != nil {
+			log.Printf("FindClickPath: %v\n", err)
+			continue
+		}
+
+		pageReports = append(pageReports, p)
+	}
+
+	return pageReports
+}
+
+// FindAnchorTexts returns the anchor texts of the internal links to the specified URL
+// with the number of links and pages using each one of them, sorted by number of links.
+func (ds *PageReportRepository) FindAnchorTexts(s string, cid int64) []models.AnchorText {
+	anchors := []models.AnchorText{}
+
+	query := `
+		SELECT
+			COALESCE(links.text, '') AS anchor,
+			count(*) AS total,
+			count(DISTINCT links.pagereport_id)
+		FROM links
+		WHERE links.url_hash = ? AND links.crawl_id = ?
+		GROUP BY anchor
+		ORDER BY total DESC, anchor ASC`
+
+	rows, err := ds.DB.Query(query, Hash(s), cid)
+	if err != nil {
+		log.Printf("FindAnchorTexts: %v\n", err)
+		return anchors
+	}
+
+	for rows.Next() {
+		a := models.AnchorText{}
+		if err := rows.Scan(&a.Text, &a.Links, &a.Pages); err != nil {
+			log.Printf("FindAnchorTexts: %v\n", err)
+			continue
+		}
+
+		anchors = append(anchors, a)
+	}
+
+	return anchors
+}
+
 // FindPageReportsRedirectingToURL returns a paginated slice of models.PageReport that are being redirected to
 // a specidied URL. The page number is set in the "p" paramenter.
 func (ds *PageReportRepository) FindPageReportsRedirectingToURL(u string, cid int64, p int) []models.PageReport {
@@ -1059,3 +1582,33 @@ func (ds *PageReportRepository) GetNumberOfPagesForRedirecting(pageReport *model
 	return int(math.Ceil(f))
 
 }
+
+// FindSiteStructurePageReports returns the crawled HTML pages of a crawl with the data
+// needed to build the site structure tree.
+func (ds *PageReportRepository) FindSiteStructurePageReports(cid int64) []models.PageReport {
+	pageReports := []models.PageReport{}
+
+	query := `
+		SELECT id, url, status_code, link_score
+		FROM pagereports
+		WHERE crawl_id = ? AND crawled = 1 AND media_type = "text/html"
+		ORDER BY url`
+
+	rows, err := ds.DB.Query(query, cid)
+	if err != nil {
+		log.Printf("FindSiteStructurePageReports: %v\n", err)
+		return pageReports
+	}
+
+	for rows.Next() {
+		p := models.PageReport{}
+		if err := rows.Scan(&p.Id, &p.URL, &p.StatusCode, &p.LinkScore); err != nil {
+			log.Printf("FindSiteStructurePageReports: %v\n", err)
+			continue
+		}
+
+		pageReports = append(pageReports, p)
+	}
+
+	return pageReports
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type PhraseRepository struct {
+	DB *sql.DB
+}
+
+// FindPhrases returns the phrases of a kind added to a project.
+func (ds *PhraseRepository) FindPhrases(projectId int64, kind string) []models.Phrase {
+	phrases := []models.Phrase{}
+
+	query := `
+		SELECT id, project_id, kind, lang, text
+		FROM phrases
+		WHERE project_id = ? AND kind = ?
+		ORDER BY lang, text`
+
+	rows, err := ds.DB.Query(query, projectId, kind)
+	if err != nil {
+		log.Println(err)
+		return phrases
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		p := models.Phrase{}
+		err := rows.Scan(&p.Id, &p.ProjectId, &p.Kind, &p.Lang, &p.Text)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		phrases = append(phrases, p)
+	}
+
+	return phrases
+}
+
+// SavePhrase stores a new phrase in a project and sets its id.
+// Phrases that already exist in the project's language and kind are ignored.
+func (ds *PhraseRepository) SavePhrase(p *models.Phrase) error {
+	query := `INSERT IGNORE INTO phrases (project_id, kind, lang, text) VALUES (?, ?, ?, ?)`
+
+	res, err := ds.DB.Exec(query, p.ProjectId, p.Kind, p.Lang, Truncate(p.Text, 255))
+	if err != nil {
+		return err
+	}
+
+	p.Id, err = res.LastInsertId()
+
+	return err
+}
+
+// DeletePhrase deletes a project's phrase.
+func (ds *PhraseRepository) DeletePhrase(id, projectId int64) error {
+	query := `DELETE FROM phrases WHERE id = ? AND project_id = ?`
+	_, err := ds.DB.Exec(query, id, projectId)
+
+	return err
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/stjudewashere/seonaut/internal/config"
@@ -66,6 +68,18 @@ func Hash(s string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// ResolvedHash returns the hash of the URL in s resolved against the base URL, so relative and
+// protocol-relative URLs have the same hash as the absolute URL used by the crawler. If s is
+// not a valid URL the hash of s is returned.
+func ResolvedHash(base *url.URL, s string) string {
+	u, err := url.Parse(s)
+	if err != nil || base == nil {
+		return Hash(s)
+	}
+
+	return Hash(base.ResolveReference(u).String())
+}
+
 // Truncate a string to the requiered length.
 func Truncate(s string, length int) string {
 	text := []rune(s)
@@ -75,3 +89,11 @@ func Truncate(s string, length int) string {
 
 	return s
 }
+
+// LikePattern converts a pattern where "*" matches any sequence of characters into
+// a pattern for the SQL LIKE operator, escaping the LIKE special characters.
+func LikePattern(pattern string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`)
+
+	return r.Replace(pattern)
+}
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stjudewashere/seonaut/internal/repository"
@@ -15,6 +16,20 @@ const (
 	hash2       = "73d942d72d2df275546b54948c19f71112007be1bba007a082563a17957cdcaa"
 )
 
+// Test relative and protocol-relative URLs are resolved against the base URL before hashing.
+func TestResolvedHash(t *testing.T) {
+	base, err := url.Parse("https://example.com/blog/post")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"/hash", "../hash", "//example.com/hash", url2} {
+		if h := repository.ResolvedHash(base, s); h != hash2 {
+			t.Errorf("ResolvedHash %s want: %s Got: %s", s, hash2, h)
+		}
+	}
+}
+
 // Test the hash function is actually hashing the strings"
 func TestHash(t *testing.T) {
 	h := repository.Hash(url1)
@@ -40,3 +55,12 @@ func TestTruncate(t *testing.T) {
 		t.Error("Error truncating short string")
 	}
 }
+
+// Test the LikePattern function escapes the LIKE special characters and converts
+// the wildcards.
+func TestLikePattern(t *testing.T) {
+	pattern := repository.LikePattern("https://example.com/blog/*?page=10%_")
+	if pattern != `https://example.com/blog/%?page=10\%\_` {
+		t.Errorf("Error converting pattern: %s", pattern)
+	}
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log"
+	"math"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type StructuredDataRepository struct {
+	DB *sql.DB
+}
+
+// structuredDataFilter returns the WHERE clause and its arguments to filter the crawl's
+// structured data entities by type. If invalid is true only the entities with a parsing
+// error or with missing required properties are included.
+func structuredDataFilter(crawlId int64, t string, invalid bool) (string, []interface{}) {
+	where := "structured_data.crawl_id = ?"
+	args := []interface{}{crawlId}
+
+	if t != "" {
+		where += " AND structured_data.type = ?"
+		args = append(args, t)
+	}
+
+	if invalid {
+		where += " AND (structured_data.error != '' OR structured_data.missing != '')"
+	}
+
+	return where, args
+}
+
+// FindStructuredDataTypes returns the distinct entity types found in the crawl sorted by name.
+func (ds *StructuredDataRepository) FindStructuredDataTypes(crawlId int64) []string {
+	types := []string{}
+
+	rows, err := ds.DB.Query("SELECT DISTINCT type FROM structured_data WHERE crawl_id = ? AND type != '' ORDER BY type ASC", crawlId)
+	if err != nil {
+		log.Printf("FindStructuredDataTypes: %v\n", err)
+		return types
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t string
+		if err := rows.Scan(&t); err != nil {
+			log.Printf("FindStructuredDataTypes: %v\n", err)
+			continue
+		}
+
+		types = append(types, t)
+	}
+
+	return types
+}
+
+// GetNumberOfPagesForStructuredData returns the number of pages in the paginator of the
+// crawl's structured data entities matching the filter.
+func (ds *StructuredDataRepository) GetNumberOfPagesForStructuredData(crawlId int64, t string, invalid bool) int {
+	where, args := structuredDataFilter(crawlId, t, invalid)
+	row := ds.DB.QueryRow("SELECT count(id) FROM structured_data WHERE "+where, args...)
+	var c int
+	if err := row.Scan(&c); err != nil {
+		log.Printf("GetNumberOfPagesForStructuredData: %v\n", err)
+	}
+	var f float64 = float64(c) / float64(paginationMax)
+	return int(math.Ceil(f))
+}
+
+// FindStructuredData returns a paginated slice with the crawl's structured data entities
+// matching the filter, sorted by type and page URL.
+func (ds *StructuredDataRepository) FindStructuredData(crawlId int64, p int, t string, invalid bool) []models.StructuredDataEntity {
+	max := paginationMax
+	offset := max * (p - 1)
+	entities := []models.StructuredDataEntity{}
+
+	where, args := structuredDataFilter(crawlId, t, invalid)
+	query := `
+		SELECT
+			structured_data.pagereport_id,
+			pagereports.url,
+			structured_data.format,
+			structured_data.type,
+			structured_data.properties,
+			structured_data.missing,
+			structured_data.error
+		FROM structured_data
+		INNER JOIN pagereports ON pagereports.id = structured_data.pagereport_id
+		WHERE ` + where + `
+		ORDER BY structured_data.type ASC, pagereports.url ASC, structured_data.id ASC
+		LIMIT ?, ?`
+
+	rows, err := ds.DB.Query(query, append(args, offset, max)...)
+	if err != nil {
+		log.Printf("FindStructuredData: %v\n", err)
+		return entities
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e := models.StructuredDataEntity{}
+		var properties, missing string
+		err := rows.Scan(&e.PageReportId, &e.URL, &e.Format, &e.Type, &properties, &missing, &e.Error)
+		if err != nil {
+			log.Printf("FindStructuredData: %v\n", err)
+			continue
+		}
+
+		decodeStructuredData(&e.StructuredData, properties, missing)
+		entities = append(entities, e)
+	}
+
+	return entities
+}
+
+// decodeStructuredData sets the entity's properties and missing properties from the
+// JSON and comma separated values stored in the database.
+func decodeStructuredData(sd *models.StructuredData, properties, missing string) {
+	if properties != "" {
+		err := json.Unmarshal([]byte(properties), &sd.Properties)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	if missing != "" {
+		sd.Missing = strings.Split(missing, ", ")
+	}
+}
@@ -65,18 +65,52 @@ func NewServer(container *services.Container) {
 	explorerHandler := explorerHandler{container}
 	mux.HandleFunc("GET /explorer", CORSHandler(container.CookieSession.Auth(explorerHandler.indexHandler)))
 
+	// Low link score pages route
+	linkScoreHandler := linkScoreHandler{container}
+	mux.HandleFunc("GET /link-score", CORSHandler(container.CookieSession.Auth(linkScoreHandler.indexHandler)))
+
+	// Site structure route
+	structureHandler := structureHandler{container}
+	mux.HandleFunc("GET /site-structure", CORSHandler(container.CookieSession.Auth(structureHandler.indexHandler)))
+
+	// Keyword cannibalization route
+	cannibalizationHandler := cannibalizationHandler{container}
+	mux.HandleFunc("GET /cannibalization", CORSHandler(container.CookieSession.Auth(cannibalizationHandler.indexHandler)))
+
+	// Heaviest pages route
+	pageWeightHandler := pageWeightHandler{container}
+	mux.HandleFunc("GET /page-weight", CORSHandler(container.CookieSession.Auth(pageWeightHandler.indexHandler)))
+
+	// Broken links route
+	brokenLinkHandler := brokenLinkHandler{container}
+	mux.HandleFunc("GET /broken-links", CORSHandler(container.CookieSession.Auth(brokenLinkHandler.indexHandler)))
+
+	// Structured data explorer route
+	structuredDataHandler := structuredDataHandler{container}
+	mux.HandleFunc("GET /structured-data", CORSHandler(container.CookieSession.Auth(structuredDataHandler.indexHandler)))
+
 	// Data export routes
 	exportHandler := exportHandler{container}
 	mux.HandleFunc("GET /export", CORSHandler(container.CookieSession.Auth(exportHandler.indexHandler)))
 	mux.HandleFunc("GET /export/csv", CORSHandler(container.CookieSession.Auth(exportHandler.csvHandler)))
 	mux.HandleFunc("GET /export/sitemap", CORSHandler(container.CookieSession.Auth(exportHandler.sitemapHandler)))
 	mux.HandleFunc("GET /export/resources", CORSHandler(container.CookieSession.Auth(exportHandler.resourcesHandler)))
+	mux.HandleFunc("GET /export/graph", CORSHandler(container.CookieSession.Auth(exportHandler.graphHandler)))
 	mux.HandleFunc("GET /export/wazc", CORSHandler(container.CookieSession.Auth(exportHandler.waczHandler)))
 
 	// Issues routes
 	issueHandler := issueHandler{container}
 	mux.HandleFunc("GET /issues", CORSHandler(container.CookieSession.Auth(issueHandler.indexHandler)))
 	mux.HandleFunc("GET /issues/view", CORSHandler(container.CookieSession.Auth(issueHandler.viewHandler)))
+	mux.HandleFunc("POST /issues/workflow", CORSHandler(container.CookieSession.Auth(issueHandler.workflowHandler)))
+	mux.HandleFunc("POST /issues/comment", CORSHandler(container.CookieSession.Auth(issueHandler.commentHandler)))
+
+	// Issue suppression routes
+	suppressionHandler := suppressionHandler{container}
+	mux.HandleFunc("GET /issues/suppress", CORSHandler(container.CookieSession.Auth(suppressionHandler.suppressGetHandler)))
+	mux.HandleFunc("POST /issues/suppress", CORSHandler(container.CookieSession.Auth(suppressionHandler.suppressPostHandler)))
+	mux.HandleFunc("GET /project/suppressions", CORSHandler(container.CookieSession.Auth(suppressionHandler.indexHandler)))
+	mux.HandleFunc("GET /project/suppressions/delete", CORSHandler(container.CookieSession.Auth(suppressionHandler.deleteHandler)))
 
 	// Project routes
 	projectHandler := projectHandler{container}
@@ -87,6 +121,41 @@ func NewServer(container *services.Container) {
 	mux.HandleFunc("POST /project/edit", CORSHandler(container.CookieSession.Auth(projectHandler.editPostHandler)))
 	mux.HandleFunc("GET /project/delete", CORSHandler(container.CookieSession.Auth(projectHandler.deleteHandler)))
 
+	// Extraction rules routes
+	extractionHandler := extractionHandler{container}
+	mux.HandleFunc("GET /project/extraction", CORSHandler(container.CookieSession.Auth(extractionHandler.indexHandler)))
+	mux.HandleFunc("POST /project/extraction", CORSHandler(container.CookieSession.Auth(extractionHandler.addHandler)))
+	mux.HandleFunc("GET /project/extraction/delete", CORSHandler(container.CookieSession.Auth(extractionHandler.deleteHandler)))
+
+	// Generic anchor texts and soft 404 patterns routes
+	phraseHandler := phraseHandler{container}
+	mux.HandleFunc("GET /project/phrases", CORSHandler(container.CookieSession.Auth(phraseHandler.indexHandler)))
+	mux.HandleFunc("POST /project/phrases", CORSHandler(container.CookieSession.Auth(phraseHandler.addHandler)))
+	mux.HandleFunc("GET /project/phrases/delete", CORSHandler(container.CookieSession.Auth(phraseHandler.deleteHandler)))
+
+	// Link position rules routes
+	positionHandler := positionHandler{container}
+	mux.HandleFunc("GET /project/link-positions", CORSHandler(container.CookieSession.Auth(positionHandler.indexHandler)))
+	mux.HandleFunc("POST /project/link-positions", CORSHandler(container.CookieSession.Auth(positionHandler.addHandler)))
+	mux.HandleFunc("GET /project/link-positions/delete", CORSHandler(container.CookieSession.Auth(positionHandler.deleteHandler)))
+
+	// Custom issue rules routes
+	customIssueHandler := customIssueHandler{container}
+	mux.HandleFunc("GET /project/issues", CORSHandler(container.CookieSession.Auth(customIssueHandler.indexHandler)))
+	mux.HandleFunc("POST /project/issues", CORSHandler(container.CookieSession.Auth(customIssueHandler.addHandler)))
+	mux.HandleFunc("GET /project/issues/delete", CORSHandler(container.CookieSession.Auth(customIssueHandler.deleteHandler)))
+
+	// Issue thresholds routes
+	thresholdsHandler := thresholdsHandler{container}
+	mux.HandleFunc("GET /project/thresholds", CORSHandler(container.CookieSession.Auth(thresholdsHandler.indexHandler)))
+	mux.HandleFunc("POST /project/thresholds", CORSHandler(container.CookieSession.Auth(thresholdsHandler.saveHandler)))
+	mux.HandleFunc("GET /project/thresholds/reset", CORSHandler(container.CookieSession.Auth(thresholdsHandler.resetHandler)))
+
+	// Issue type settings routes
+	issueTypesHandler := issueTypesHandler{container}
+	mux.HandleFunc("GET /project/issue-types", CORSHandler(container.CookieSession.Auth(issueTypesHandler.indexHandler)))
+	mux.HandleFunc("POST /project/issue-types", CORSHandler(container.CookieSession.Auth(issueTypesHandler.saveHandler)))
+
 	// Resource route
 	resourceHandler := resourceHandler{container}
 	mux.HandleFunc("GET /resources", CORSHandler(container.CookieSession.Auth(resourceHandler.indexHandler)))
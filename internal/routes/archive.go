@@ -68,6 +68,7 @@ func (h *archiveHandler) archiveHandler(w http.ResponseWriter, r *http.Request)
 		PageReportView *models.PageReportView
 		ProjectView    *models.ProjectView
 		Eid            string
+		IssueName      string
 		Ep             string
 		ArchiveRecord  *models.ArchiveRecord
 		IsText         bool
@@ -75,6 +76,7 @@ func (h *archiveHandler) archiveHandler(w http.ResponseWriter, r *http.Request)
 		ProjectView:    pv,
 		PageReportView: pageReportView,
 		Eid:            eid,
+		IssueName:      h.IssueService.GetIssueName(pv.Project.Id, eid),
 		Ep:             ep,
 		ArchiveRecord:  record,
 		IsText:         isText,
@@ -0,0 +1,58 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type brokenLinkHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the broken links request.
+// It lists the links, resources, canonicals and hreflangs pointing to URLs with an error
+// status code, with one row per source page and target URL. It expects a query parameter
+// "pid" containing the project id, the "p" parameter containing the current page in the
+// paginator and an optional "sort" parameter, which can be "sources" or "url".
+func (h *brokenLinkHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("p"))
+	if err != nil {
+		page = 1
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view, err := h.BrokenLinkService.GetPaginatedBrokenLinks(pv.Crawl.Id, page, r.URL.Query().Get("sort"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view.ProjectView = pv
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "BROKEN_LINKS_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "broken_links", v)
+}
@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type cannibalizationHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the keyword cannibalization request.
+// It renders the clusters of indexable pages that compete for the same keywords.
+// It expects a query parameter "pid" containing the project id.
+func (h *cannibalizationHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view := models.CannibalizationView{
+		ProjectView: pv,
+		Clusters:    h.CannibalizationService.GetClusters(pv.Crawl.Id),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "CANNIBALIZATION_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "cannibalization", v)
+}
@@ -4,7 +4,6 @@ import (
 	"net/http"
 	"os"
 	"strings"
-	"github.com/stjudewashere/seonaut/internal/services"
 )
 
 // CORSMiddleware handles Cross-Origin Resource Sharing (CORS) for API requests
@@ -0,0 +1,138 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type customIssueHandler struct {
+	*services.Container
+}
+
+// indexHandler lists the project's custom issue rules and displays the form to add new ones.
+// It expects a query parameter "pid" containing the project id.
+func (h *customIssueHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.renderCustomIssueRules(w, user, &p, nil)
+}
+
+// addHandler validates and stores a new custom issue rule in the project.
+// It expects a query parameter "pid" containing the project id.
+// In case of error the form is displayed again with an error message.
+func (h *customIssueHandler) addHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	// An invalid priority is rejected when validating the rule.
+	priority, _ := strconv.Atoi(r.FormValue("priority"))
+
+	rule := &models.CustomIssueRule{
+		ProjectId: p.Id,
+		Name:      r.FormValue("name"),
+		Priority:  priority,
+		Source:    r.FormValue("source"),
+		Subject:   r.FormValue("subject"),
+		Operator:  r.FormValue("operator"),
+		Value:     r.FormValue("value"),
+	}
+
+	err = h.CustomIssueService.SaveRule(rule)
+	if err != nil {
+		h.renderCustomIssueRules(w, user, &p, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/issues?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// deleteHandler removes a custom issue rule from the project, as well as the issues it reported.
+// It expects the query parameters "pid" with the project id and "id" with the rule id.
+func (h *customIssueHandler) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.CustomIssueService.DeleteRule(id, p.Id)
+
+	http.Redirect(w, r, "/project/issues?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderCustomIssueRules renders the custom issues template with the project's rules.
+func (h *customIssueHandler) renderCustomIssueRules(w http.ResponseWriter, user *models.User, p *models.Project, err error) {
+	data := &struct {
+		Project models.Project
+		Rules   []models.CustomIssueRule
+		Error   error
+	}{
+		Project: *p,
+		Rules:   h.CustomIssueService.GetRules(p.Id),
+		Error:   err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_issues", &PageView{
+		User:      *user,
+		PageTitle: "CUSTOM_ISSUES_PAGE_TITLE",
+		Data:      data,
+	})
+}
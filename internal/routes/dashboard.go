@@ -47,6 +47,7 @@ func (h *dashboardHandler) indexHandler(w http.ResponseWriter, r *http.Request)
 		AltCount          *models.AltCount
 		SchemeCount       *models.SchemeCount
 		StatusCodeByDepth []models.StatusCodeByDepth
+		SecuritySummary   []models.HostIssues
 	}{
 		ProjectView:       pv,
 		MediaChart:        h.DashboardService.GetMediaCount(pv.Crawl.Id),
@@ -56,6 +57,7 @@ func (h *dashboardHandler) indexHandler(w http.ResponseWriter, r *http.Request)
 		AltCount:          h.DashboardService.GetImageAltCount(pv.Crawl.Id),
 		SchemeCount:       h.DashboardService.GetSchemeCount(pv.Crawl.Id),
 		StatusCodeByDepth: h.DashboardService.GetStatusCodeByDepth(pv.Crawl.Id),
+		SecuritySummary:   h.DashboardService.GetSecuritySummary(pv.Crawl.Id),
 	}
 
 	pageView := &PageView{
@@ -152,16 +152,19 @@ func (h *exportHandler) resourcesHandler(w http.ResponseWriter, r *http.Request)
 	t := r.URL.Query().Get("t")
 
 	m := map[string]func(io.Writer, *models.Crawl){
-		"internal":  h.ExportService.ExportLinks,
-		"external":  h.ExportService.ExportExternalLinks,
-		"images":    h.ExportService.ExportImages,
-		"scripts":   h.ExportService.ExportScripts,
-		"styles":    h.ExportService.ExportStyles,
-		"iframes":   h.ExportService.ExportIframes,
-		"audios":    h.ExportService.ExportAudios,
-		"videos":    h.ExportService.ExportVideos,
-		"hreflangs": h.ExportService.ExportHreflangs,
-		"issues":    h.ExportService.ExportAllIssues,
+		"internal":    h.ExportService.ExportLinks,
+		"external":    h.ExportService.ExportExternalLinks,
+		"images":      h.ExportService.ExportImages,
+		"scripts":     h.ExportService.ExportScripts,
+		"styles":      h.ExportService.ExportStyles,
+		"iframes":     h.ExportService.ExportIframes,
+		"audios":      h.ExportService.ExportAudios,
+		"videos":      h.ExportService.ExportVideos,
+		"hreflangs":   h.ExportService.ExportHreflangs,
+		"issues":      h.ExportService.ExportAllIssues,
+		"extractions": h.ExportService.ExportExtractions,
+		"anchors":     h.ExportService.ExportAnchorTexts,
+		"broken":      h.ExportService.ExportBrokenLinks,
 	}
 
 	e, ok := m[t]
@@ -175,6 +178,47 @@ func (h *exportHandler) resourcesHandler(w http.ResponseWriter, r *http.Request)
 	e(w, &pv.Crawl)
 }
 
+// graphHandler exports the internal link graph of a specific project.
+// It expects a "pid" query parameter with the project's id as well as a query
+// parameter "f" with the file format, which can be "graphml" or "gexf".
+func (h *exportHandler) graphHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	f := r.URL.Query().Get("f")
+
+	m := map[string]func(io.Writer, *models.Crawl){
+		"graphml": h.ExportService.ExportGraphML,
+		"gexf":    h.ExportService.ExportGEXF,
+	}
+
+	e, ok := m[f]
+	if !ok {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	fileName := pv.Project.Host + " links " + time.Now().Format("2006-01-02")
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.%s\"", fileName, f))
+	e(w, &pv.Crawl)
+}
+
 // waczHandler exports the WACZ archive of a specific project.
 // It expects a "pid" query parameter with the project's id. It checks if
 // the file exists before passing it to the response.
@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type extractionHandler struct {
+	*services.Container
+}
+
+// indexHandler lists the project's extraction rules and displays the form to add new ones.
+// It expects a query parameter "pid" containing the project id.
+func (h *extractionHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.renderExtractionRules(w, user, &p, nil)
+}
+
+// addHandler validates and stores a new extraction rule in the project.
+// It expects a query parameter "pid" containing the project id.
+// In case of error the form is displayed again with an error message.
+func (h *extractionHandler) addHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	rule := &models.ExtractionRule{
+		ProjectId:  p.Id,
+		Name:       r.FormValue("name"),
+		Type:       r.FormValue("type"),
+		Expression: r.FormValue("expression"),
+		Mode:       r.FormValue("mode"),
+	}
+
+	err = h.ExtractionService.SaveRule(rule)
+	if err != nil {
+		h.renderExtractionRules(w, user, &p, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/extraction?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// deleteHandler removes an extraction rule from the project.
+// It expects the query parameters "pid" with the project id and "id" with the rule id.
+func (h *extractionHandler) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.ExtractionService.DeleteRule(id, p.Id)
+
+	http.Redirect(w, r, "/project/extraction?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderExtractionRules renders the extraction rules template with the project's rules.
+func (h *extractionHandler) renderExtractionRules(w http.ResponseWriter, user *models.User, p *models.Project, err error) {
+	data := &struct {
+		Project models.Project
+		Rules   []models.ExtractionRule
+		Error   error
+	}{
+		Project: *p,
+		Rules:   h.ExtractionService.GetRules(p.Id),
+		Error:   err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_extraction", &PageView{
+		User:      *user,
+		PageTitle: "EXTRACTION_RULES_PAGE_TITLE",
+		Data:      data,
+	})
+}
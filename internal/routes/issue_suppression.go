@@ -0,0 +1,212 @@
+package routes
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type suppressionHandler struct {
+	*services.Container
+}
+
+// suppressionFormView is the data used in the issue suppression form.
+type suppressionFormView struct {
+	ProjectView *models.ProjectView
+	Eid         string
+	IssueName   string
+	Rid         int
+	Suppression models.IssueSuppression
+	Error       error
+}
+
+// suppressGetHandler displays the form to suppress an issue type in a page URL or in the
+// URLs matching a pattern. It expects the query parameters "pid" with the project id, "eid"
+// with the issue type and "rid" with the id of the page report used to fill in the URL.
+func (h *suppressionHandler) suppressGetHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	eid := r.URL.Query().Get("eid")
+	if eid == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	rid, err := strconv.Atoi(r.URL.Query().Get("rid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pageReportView := h.ReportService.GetPageReport(rid, pv.Crawl.Id, "", 1)
+
+	h.renderSuppressionForm(w, user, &suppressionFormView{
+		ProjectView: pv,
+		Eid:         eid,
+		IssueName:   h.IssueService.GetIssueName(pv.Project.Id, eid),
+		Rid:         rid,
+		Suppression: models.IssueSuppression{URL: pageReportView.PageReport.URL},
+	})
+}
+
+// suppressPostHandler validates and stores the issue suppression, setting the current user
+// as its author. In case of error the form is displayed again with an error message.
+func (h *suppressionHandler) suppressPostHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	eid := r.URL.Query().Get("eid")
+	if eid == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	// The page report id is only used to display the form again.
+	rid, _ := strconv.Atoi(r.URL.Query().Get("rid"))
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	suppression := &models.IssueSuppression{
+		ProjectId: pv.Project.Id,
+		ErrorType: eid,
+		Comment:   r.FormValue("comment"),
+		UserId:    user.Id,
+	}
+
+	if r.FormValue("target") == "pattern" {
+		suppression.Pattern = r.FormValue("pattern")
+	} else {
+		suppression.URL = r.FormValue("url")
+	}
+
+	err = h.SuppressionService.Suppress(suppression)
+	if err != nil {
+		h.renderSuppressionForm(w, user, &suppressionFormView{
+			ProjectView: pv,
+			Eid:         eid,
+			IssueName:   h.IssueService.GetIssueName(pv.Project.Id, eid),
+			Rid:         rid,
+			Suppression: *suppression,
+			Error:       err,
+		})
+		return
+	}
+
+	redirect := "/issues/view?pid=" + strconv.FormatInt(pv.Project.Id, 10) + "&eid=" + url.QueryEscape(eid)
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+}
+
+// indexHandler lists the project's issue suppressions.
+// It expects a query parameter "pid" containing the project id.
+func (h *suppressionHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	data := &struct {
+		Project      models.Project
+		Suppressions []models.IssueSuppression
+	}{
+		Project:      p,
+		Suppressions: h.SuppressionService.GetSuppressions(p.Id),
+	}
+
+	h.Renderer.RenderTemplate(w, "project_suppressions", &PageView{
+		User:      *user,
+		PageTitle: "ISSUE_SUPPRESSIONS_PAGE_TITLE",
+		Data:      data,
+	})
+}
+
+// deleteHandler removes an issue suppression from the project so its issues are reported again.
+// It expects the query parameters "pid" with the project id and "id" with the suppression id.
+func (h *suppressionHandler) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.SuppressionService.DeleteSuppression(id, p.Id)
+
+	http.Redirect(w, r, "/project/suppressions?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderSuppressionForm renders the issue suppression form template.
+func (h *suppressionHandler) renderSuppressionForm(w http.ResponseWriter, user *models.User, data *suppressionFormView) {
+	h.Renderer.RenderTemplate(w, "issues_suppress", &PageView{
+		User:      *user,
+		PageTitle: "ISSUE_SUPPRESS_PAGE_TITLE",
+		Data:      data,
+	})
+}
@@ -0,0 +1,108 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type issueTypesHandler struct {
+	*services.Container
+}
+
+// indexHandler displays the form with the project's issue type settings.
+// It expects a query parameter "pid" containing the project id.
+func (h *issueTypesHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.renderIssueTypes(w, user, &p, nil)
+}
+
+// saveHandler stores the project's issue type settings. The form contains a "priority_<id>"
+// field and an optional "disabled_<id>" checkbox for each issue type id.
+// It expects a query parameter "pid" containing the project id.
+func (h *issueTypesHandler) saveHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	settings := make(map[int]models.IssueTypeSetting)
+	for _, s := range h.IssueTypeService.GetSettings(p.Id) {
+		priority, err := strconv.Atoi(r.FormValue(fmt.Sprintf("priority_%d", s.IssueTypeId)))
+		if err != nil {
+			continue
+		}
+
+		settings[s.IssueTypeId] = models.IssueTypeSetting{
+			IssueTypeId: s.IssueTypeId,
+			Priority:    priority,
+			Disabled:    r.FormValue(fmt.Sprintf("disabled_%d", s.IssueTypeId)) == "1",
+		}
+	}
+
+	err = h.IssueTypeService.SaveSettings(p.Id, settings)
+	if err != nil {
+		h.renderIssueTypes(w, user, &p, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/edit?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderIssueTypes renders the issue types template with the project's issue type settings.
+func (h *issueTypesHandler) renderIssueTypes(w http.ResponseWriter, user *models.User, p *models.Project, err error) {
+	data := &struct {
+		Project  models.Project
+		Settings []models.IssueTypeSetting
+		Error    error
+	}{
+		Project:  *p,
+		Settings: h.IssueTypeService.GetSettings(p.Id),
+		Error:    err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_issue_types", &PageView{
+		User:      *user,
+		PageTitle: "ISSUE_TYPES_PAGE_TITLE",
+		Data:      data,
+	})
+}
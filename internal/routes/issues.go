@@ -2,7 +2,9 @@ package routes
 
 import (
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/services"
@@ -54,7 +56,8 @@ func (h *issueHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
 
 // viewHandler handles the view of the project's issues by an specific type.
 // It expects a query parameter "pid" containing the project id and an "eid" parameter
-// containing the issue type.
+// containing the issue type. The optional "suppressed" parameter set to "1" shows the
+// page reports with suppressed issues.
 func (h *issueHandler) viewHandler(w http.ResponseWriter, r *http.Request) {
 	user, ok := h.CookieSession.GetUser(r.Context())
 	if !ok {
@@ -79,22 +82,39 @@ func (h *issueHandler) viewHandler(w http.ResponseWriter, r *http.Request) {
 		page = 1
 	}
 
+	suppressed := r.URL.Query().Get("suppressed") == "1"
+
 	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
 	if err != nil {
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return
 	}
 
-	paginatorView, err := h.IssueService.GetPaginatedReportsByIssue(pv.Crawl.Id, page, eid)
+	paginatorView, err := h.IssueService.GetPaginatedReportsByIssue(pv.Crawl.Id, page, eid, suppressed)
 	if err != nil {
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return
 	}
 
+	// The workflow is not displayed if the issue type doesn't exist in the project.
+	workflow, _ := h.WorkflowService.GetWorkflow(pv.Project.Id, eid)
+
 	data := models.IssuesView{
 		ProjectView:   pv,
 		Eid:           eid,
+		IssueName:     h.IssueService.GetIssueName(pv.Project.Id, eid),
+		Suppressed:    suppressed,
 		PaginatorView: paginatorView,
+		Workflow:      workflow,
+		Assignees:     h.WorkflowService.GetAssignees(pv.Project.Id),
+		Statuses:      services.IssueStatuses,
+		WorkflowError: r.URL.Query().Get("error") != "",
+	}
+
+	// Near duplicates are listed along with the pages using the project's similarity threshold.
+	if eid == services.NearDuplicateIssueType {
+		minSimilarity := h.ThresholdsService.GetThresholds(pv.Project.Id).MinSimilarity
+		data.NearDuplicates = h.IssueService.GetNearDuplicates(pv.Crawl.Id, paginatorView.PageReports, minSimilarity)
 	}
 
 	v := &PageView{
@@ -105,3 +125,123 @@ func (h *issueHandler) viewHandler(w http.ResponseWriter, r *http.Request) {
 
 	h.Renderer.RenderTemplate(w, "issues_view", v)
 }
+
+// workflowHandler updates the status, assignee and due date of an issue type in the project.
+// It expects the query parameters "pid" with the project id and "eid" with the issue type.
+// The due date is expected in the YYYY-MM-DD format, and it is removed if it's empty.
+func (h *issueHandler) workflowHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	eid := r.URL.Query().Get("eid")
+	if eid == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	workflow := &models.IssueWorkflow{
+		ProjectId: p.Id,
+		ErrorType: eid,
+		Status:    r.FormValue("status"),
+	}
+
+	redirect := "/issues/view?pid=" + strconv.FormatInt(p.Id, 10) + "&eid=" + url.QueryEscape(eid)
+
+	// An empty assignee removes it. Assignees that are not one of the project's users
+	// are rejected when saving the workflow.
+	if a := r.FormValue("assignee"); a != "" {
+		workflow.AssigneeId, err = strconv.Atoi(a)
+		if err != nil {
+			http.Redirect(w, r, redirect+"&error=1", http.StatusSeeOther)
+			return
+		}
+	}
+
+	if d := r.FormValue("due_date"); d != "" {
+		workflow.DueDate, err = time.Parse("2006-01-02", d)
+		if err != nil {
+			http.Redirect(w, r, redirect+"&error=1", http.StatusSeeOther)
+			return
+		}
+	}
+
+	err = h.WorkflowService.SaveWorkflow(workflow)
+	if err != nil {
+		http.Redirect(w, r, redirect+"&error=1", http.StatusSeeOther)
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+}
+
+// commentHandler adds a comment by the current user to the issue type's workflow.
+// It expects the query parameters "pid" with the project id and "eid" with the issue type.
+func (h *issueHandler) commentHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	eid := r.URL.Query().Get("eid")
+	if eid == "" {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	comment := &models.IssueComment{
+		ProjectId: p.Id,
+		ErrorType: eid,
+		UserId:    user.Id,
+		Comment:   r.FormValue("comment"),
+	}
+
+	redirect := "/issues/view?pid=" + strconv.FormatInt(p.Id, 10) + "&eid=" + url.QueryEscape(eid)
+
+	err = h.WorkflowService.AddComment(comment)
+	if err != nil {
+		http.Redirect(w, r, redirect+"&error=1", http.StatusSeeOther)
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusSeeOther)
+}
@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type positionHandler struct {
+	*services.Container
+}
+
+// indexHandler lists the project's link position rules and displays the form to add new ones.
+// It expects a query parameter "pid" containing the project id.
+func (h *positionHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.renderLinkPositions(w, user, &p, nil)
+}
+
+// addHandler validates and stores a new link position rule in the project.
+// It expects a query parameter "pid" containing the project id.
+// In case of error the form is displayed again with an error message.
+func (h *positionHandler) addHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	rule := &models.LinkPositionRule{
+		ProjectId: p.Id,
+		XPath:     r.FormValue("xpath"),
+		Position:  r.FormValue("position"),
+	}
+
+	err = h.PositionService.SaveRule(rule)
+	if err != nil {
+		h.renderLinkPositions(w, user, &p, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/link-positions?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// deleteHandler removes a link position rule from the project.
+// It expects the query parameters "pid" with the project id and "id" with the rule id.
+func (h *positionHandler) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.PositionService.DeleteRule(id, p.Id)
+
+	http.Redirect(w, r, "/project/link-positions?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderLinkPositions renders the link positions template with the project's rules.
+func (h *positionHandler) renderLinkPositions(w http.ResponseWriter, user *models.User, p *models.Project, err error) {
+	data := &struct {
+		Project   models.Project
+		Rules     []models.LinkPositionRule
+		Positions []string
+		Error     error
+	}{
+		Project:   *p,
+		Rules:     h.PositionService.GetRules(p.Id),
+		Positions: models.LinkPositions,
+		Error:     err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_link_positions", &PageView{
+		User:      *user,
+		PageTitle: "LINK_POSITIONS_PAGE_TITLE",
+		Data:      data,
+	})
+}
@@ -0,0 +1,62 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type linkScoreHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the low link score pages request.
+// It lists the indexable pages included in the sitemap that have a low internal link score.
+// It expects a query parameter "pid" containing the project id and the "p" parameter containing
+// the current page in the paginator.
+func (h *linkScoreHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("p"))
+	if err != nil {
+		page = 1
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	paginatorView, err := h.LinkScoreService.GetPaginatedLowLinkScore(pv.Crawl.Id, page)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view := models.LinkScoreView{
+		ProjectView:   pv,
+		Threshold:     services.LinkScoreThreshold,
+		PaginatorView: paginatorView,
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "LINK_SCORE_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "link_score", v)
+}
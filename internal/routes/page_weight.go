@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type pageWeightHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the heaviest pages request.
+// It lists the crawled HTML pages sorted by their total weight with a breakdown by resource type.
+// It expects a query parameter "pid" containing the project id and the "p" parameter containing
+// the current page in the paginator.
+func (h *pageWeightHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("p"))
+	if err != nil {
+		page = 1
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view, err := h.PageWeightService.GetPaginatedHeaviestPages(pv.Crawl.Id, page)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view.ProjectView = pv
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "PAGE_WEIGHT_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "page_weight", v)
+}
@@ -0,0 +1,162 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type phraseHandler struct {
+	*services.Container
+}
+
+// phrasePageTitles contains the page title of each phrase kind.
+var phrasePageTitles = map[string]string{
+	models.PhraseGenericAnchor: "GENERIC_ANCHORS_PAGE_TITLE",
+	models.PhraseSoft404:       "SOFT_404_PATTERNS_PAGE_TITLE",
+}
+
+// indexHandler lists the project's phrases of a kind and displays the form to add new ones.
+// It expects the query parameters "pid" containing the project id and "kind" with the phrase kind.
+func (h *phraseHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	kind := r.URL.Query().Get("kind")
+	if !services.IsPhraseKind(kind) {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.renderPhrases(w, user, &p, kind, nil)
+}
+
+// addHandler validates and stores a new phrase in the project.
+// It expects the query parameters "pid" containing the project id and "kind" with the phrase kind.
+// In case of error the form is displayed again with an error message.
+func (h *phraseHandler) addHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	kind := r.URL.Query().Get("kind")
+	if !services.IsPhraseKind(kind) {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	phrase := &models.Phrase{
+		ProjectId: p.Id,
+		Kind:      kind,
+		Lang:      r.FormValue("lang"),
+		Text:      r.FormValue("text"),
+	}
+
+	err = h.PhraseService.AddPhrase(phrase)
+	if err != nil {
+		h.renderPhrases(w, user, &p, kind, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/phrases?pid="+strconv.FormatInt(p.Id, 10)+"&kind="+kind, http.StatusSeeOther)
+}
+
+// deleteHandler removes a phrase from the project.
+// It expects the query parameters "pid" with the project id, "kind" with the phrase kind and
+// "id" with the phrase id.
+func (h *phraseHandler) deleteHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	kind := r.URL.Query().Get("kind")
+	if !services.IsPhraseKind(kind) {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.PhraseService.DeletePhrase(id, p.Id)
+
+	http.Redirect(w, r, "/project/phrases?pid="+strconv.FormatInt(p.Id, 10)+"&kind="+kind, http.StatusSeeOther)
+}
+
+// renderPhrases renders the phrases template with the default phrases of the kind and the
+// project's phrases.
+func (h *phraseHandler) renderPhrases(w http.ResponseWriter, user *models.User, p *models.Project, kind string, err error) {
+	data := &struct {
+		Project  models.Project
+		Kind     string
+		Defaults []models.Phrase
+		Phrases  []models.Phrase
+		Error    error
+	}{
+		Project:  *p,
+		Kind:     kind,
+		Defaults: h.PhraseService.GetDefaultPhrases(kind),
+		Phrases:  h.PhraseService.GetPhrases(p.Id, kind),
+		Error:    err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_phrases", &PageView{
+		User:      *user,
+		PageTitle: phrasePageTitles[kind],
+		Data:      data,
+	})
+}
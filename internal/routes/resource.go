@@ -71,6 +71,7 @@ func (h *resourceHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
 		PageReportView *models.PageReportView
 		ProjectView    *models.ProjectView
 		Eid            string
+		IssueName      string
 		Ep             string
 		Tab            string
 		Archived       bool
@@ -78,6 +79,7 @@ func (h *resourceHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
 	}{
 		ProjectView:    pv,
 		Eid:            eid,
+		IssueName:      h.IssueService.GetIssueName(pv.Project.Id, eid),
 		Ep:             ep,
 		Tab:            tab,
 		PageReportView: pageReportView,
@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type structureHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the site structure request.
+// It renders the directory tree of the crawled pages.
+// It expects a query parameter "pid" containing the project id.
+func (h *structureHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view := models.SiteStructureView{
+		ProjectView: pv,
+		Root:        h.StructureService.GetSiteStructure(pv.Crawl.Id),
+	}
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "SITE_STRUCTURE_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "site_structure", v)
+}
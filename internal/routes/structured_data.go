@@ -0,0 +1,62 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type structuredDataHandler struct {
+	*services.Container
+}
+
+// indexHandler handles the structured data explorer request.
+// It lists the structured data entities found in all the crawled pages, linking each entity
+// to its page report. It expects a query parameter "pid" containing the project id, the "p"
+// parameter containing the current page in the paginator, an optional "type" parameter to
+// filter the entities by type and an optional "invalid" parameter to list only the entities
+// with validation errors.
+func (h *structuredDataHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	page, err := strconv.Atoi(r.URL.Query().Get("p"))
+	if err != nil {
+		page = 1
+	}
+
+	pv, err := h.ProjectViewService.GetProjectView(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	t := r.URL.Query().Get("type")
+	invalid := r.URL.Query().Get("invalid") == "1"
+
+	view, err := h.StructuredDataService.GetPaginatedStructuredData(pv.Crawl.Id, page, t, invalid)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	view.ProjectView = pv
+
+	v := &PageView{
+		Data:      view,
+		User:      *user,
+		PageTitle: "STRUCTURED_DATA_PAGE_TITLE",
+	}
+
+	h.Renderer.RenderTemplate(w, "structured_data", v)
+}
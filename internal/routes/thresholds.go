@@ -0,0 +1,150 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type thresholdsHandler struct {
+	*services.Container
+}
+
+// indexHandler displays the form with the project's issue thresholds.
+// It expects a query parameter "pid" containing the project id.
+func (h *thresholdsHandler) indexHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	t := h.ThresholdsService.GetThresholds(p.Id)
+	h.renderThresholds(w, user, &p, &t, nil)
+}
+
+// saveHandler validates and stores the project's issue thresholds.
+// It expects a query parameter "pid" containing the project id.
+// In case of error the form is displayed again with an error message.
+func (h *thresholdsHandler) saveHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	// Invalid numbers are parsed as 0 and rejected when validating the thresholds.
+	formInt := func(name string) int {
+		v, _ := strconv.Atoi(r.FormValue(name))
+		return v
+	}
+
+	maxImageSize, _ := strconv.ParseInt(r.FormValue("max_image_size"), 10, 64)
+	maxPageWeight, _ := strconv.ParseInt(r.FormValue("max_page_weight"), 10, 64)
+	maxScriptsWeight, _ := strconv.ParseInt(r.FormValue("max_scripts_weight"), 10, 64)
+	maxLegacyImageSize, _ := strconv.ParseInt(r.FormValue("max_legacy_image_size"), 10, 64)
+
+	t := &models.IssueThresholds{
+		ProjectId:            p.Id,
+		MinWords:             formInt("min_words"),
+		MaxDOMSize:           formInt("max_dom_size"),
+		MinTitleLength:       formInt("min_title_length"),
+		MaxTitleLength:       formInt("max_title_length"),
+		MinDescriptionLength: formInt("min_description_length"),
+		MaxDescriptionLength: formInt("max_description_length"),
+		MaxTTFB:              formInt("max_ttfb"),
+		MaxImageSize:         maxImageSize,
+		MaxAltTextLength:     formInt("max_alt_text_length"),
+		MaxLinks:             formInt("max_links"),
+		MinSimilarity:        formInt("min_similarity"),
+		MaxPageWeight:        maxPageWeight,
+		MaxScriptsWeight:     maxScriptsWeight,
+		MaxPageRequests:      formInt("max_page_requests"),
+		MaxLegacyImageSize:   maxLegacyImageSize,
+	}
+
+	err = h.ThresholdsService.SaveThresholds(t)
+	if err != nil {
+		h.renderThresholds(w, user, &p, t, err)
+		return
+	}
+
+	http.Redirect(w, r, "/project/edit?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// resetHandler removes the project's issue thresholds so the default values are used.
+// It expects a query parameter "pid" containing the project id.
+func (h *thresholdsHandler) resetHandler(w http.ResponseWriter, r *http.Request) {
+	user, ok := h.CookieSession.GetUser(r.Context())
+	if !ok {
+		http.Redirect(w, r, "/signout", http.StatusSeeOther)
+		return
+	}
+
+	pid, err := strconv.Atoi(r.URL.Query().Get("pid"))
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	p, err := h.ProjectService.FindProject(pid, user.Id)
+	if err != nil {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	h.ThresholdsService.ResetThresholds(p.Id)
+
+	http.Redirect(w, r, "/project/thresholds?pid="+strconv.FormatInt(p.Id, 10), http.StatusSeeOther)
+}
+
+// renderThresholds renders the thresholds template with the project's thresholds.
+func (h *thresholdsHandler) renderThresholds(w http.ResponseWriter, user *models.User, p *models.Project, t *models.IssueThresholds, err error) {
+	data := &struct {
+		Project    models.Project
+		Thresholds *models.IssueThresholds
+		Error      error
+	}{
+		Project:    *p,
+		Thresholds: t,
+		Error:      err,
+	}
+
+	h.Renderer.RenderTemplate(w, "project_thresholds", &PageView{
+		User:      *user,
+		PageTitle: "ISSUE_THRESHOLDS_PAGE_TITLE",
+		Data:      data,
+	})
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	BrokenLinkServiceRepository interface {
+		FindCrawlBrokenLinks(crawlId int64) []models.BrokenLink
+		SaveBrokenLinks(crawlId int64, links []models.BrokenLink) error
+		GetNumberOfPagesForBrokenLinks(crawlId int64) int
+		FindBrokenLinks(crawlId int64, p int, sort string) []models.BrokenLink
+	}
+
+	BrokenLinkService struct {
+		repository BrokenLinkServiceRepository
+	}
+)
+
+func NewBrokenLinkService(r BrokenLinkServiceRepository) *BrokenLinkService {
+	return &BrokenLinkService{
+		repository: r,
+	}
+}
+
+// UpdateBrokenLinks finds the crawl's links, resources, canonicals and hreflangs pointing to
+// URLs with an error status code and stores them with their number of source pages. It must be
+// called once all the crawl's pages and resources have been stored.
+func (s *BrokenLinkService) UpdateBrokenLinks(crawl *models.Crawl) {
+	links := s.repository.FindCrawlBrokenLinks(crawl.Id)
+	CountBrokenLinkSources(links)
+
+	err := s.repository.SaveBrokenLinks(crawl.Id, links)
+	if err != nil {
+		log.Printf("UpdateBrokenLinks: %v\n", err)
+	}
+}
+
+// GetPaginatedBrokenLinks returns a BrokenLinkView with the crawl's broken links sorted by the
+// number of source pages, or by the target URL if sort is "url". The view's ProjectView is
+// left empty.
+func (s *BrokenLinkService) GetPaginatedBrokenLinks(crawlId int64, currentPage int, sort string) (models.BrokenLinkView, error) {
+	if sort != "url" {
+		sort = "sources"
+	}
+
+	paginator := models.Paginator{
+		TotalPages:  s.repository.GetNumberOfPagesForBrokenLinks(crawlId),
+		CurrentPage: currentPage,
+	}
+
+	if currentPage < 1 || (currentPage > paginator.TotalPages && currentPage > 1) {
+		return models.BrokenLinkView{}, errors.New("page out of bounds")
+	}
+
+	if currentPage < paginator.TotalPages {
+		paginator.NextPage = currentPage + 1
+	}
+
+	if currentPage > 1 {
+		paginator.PreviousPage = currentPage - 1
+	}
+
+	return models.BrokenLinkView{
+		Paginator:   paginator,
+		BrokenLinks: s.repository.FindBrokenLinks(crawlId, currentPage, sort),
+		Sort:        sort,
+	}, nil
+}
+
+// CountBrokenLinkSources sets the number of distinct source pages referencing the target URL
+// of each one of the broken links.
+func CountBrokenLinkSources(links []models.BrokenLink) {
+	sources := make(map[string]map[int64]bool)
+	for _, l := range links {
+		if sources[l.URL] == nil {
+			sources[l.URL] = make(map[int64]bool)
+		}
+
+		sources[l.URL][l.PageReportId] = true
+	}
+
+	for i := range links {
+		links[i].Sources = len(sources[links[i].URL])
+	}
+}
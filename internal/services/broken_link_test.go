@@ -0,0 +1,28 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestCountBrokenLinkSources(t *testing.T) {
+	// The 404 page is linked twice from page 1 and once from page 2, while the
+	// missing image is only referenced by page 2.
+	links := []models.BrokenLink{
+		{PageReportId: 1, URL: "https://example.com/404", Type: models.BrokenLinkLink},
+		{PageReportId: 1, URL: "https://example.com/404", Type: models.BrokenLinkLink},
+		{PageReportId: 2, URL: "https://example.com/404", Type: models.BrokenLinkCanonical},
+		{PageReportId: 2, URL: "https://example.com/missing.png", Type: models.BrokenLinkImage},
+	}
+
+	services.CountBrokenLinkSources(links)
+
+	want := []int{2, 2, 2, 1}
+	for i, l := range links {
+		if l.Sources != want[i] {
+			t.Errorf("broken link %d sources want: %d Got: %d", i, want[i], l.Sources)
+		}
+	}
+}
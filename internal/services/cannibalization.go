@@ -0,0 +1,253 @@
+package services
+
+import (
+	"log"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const (
+	// Pages that share at least this ratio of their terms compete for the same keywords.
+	cannibalizationMinOverlap = 0.6
+
+	// Pages must share at least this number of terms to compete for the same keywords.
+	cannibalizationMinTerms = 2
+
+	// Terms found in more than this ratio of the pages in the same language, such as the site's
+	// name in the titles, are ignored. Terms found in cannibalizationSiteWideMin pages or less are
+	// always taken into account, so small sites are not left without terms.
+	cannibalizationSiteWideRatio = 0.2
+	cannibalizationSiteWideMin   = 10
+)
+
+// cannibalizationStopWords contains the words that are removed from the titles, H1s and slugs
+// of the pages in each language before comparing them. Pages in other languages use the stop
+// words of all the languages.
+var cannibalizationStopWords = map[string][]string{
+	"en": {"a", "an", "and", "are", "as", "at", "be", "by", "for", "from", "how", "in", "is", "it", "its", "of", "on", "or", "our", "that", "the", "this", "to", "vs", "what", "when", "where", "which", "who", "why", "will", "with", "you", "your"},
+	"es": {"a", "al", "como", "con", "de", "del", "el", "en", "es", "la", "las", "lo", "los", "para", "por", "que", "qué", "se", "su", "sus", "sin", "sobre", "tu", "un", "una", "unos", "unas", "y", "o"},
+	"fr": {"a", "à", "au", "aux", "avec", "ce", "comment", "dans", "de", "des", "du", "en", "est", "et", "la", "le", "les", "leur", "ou", "par", "pour", "que", "qui", "sur", "un", "une", "vos", "votre"},
+	"de": {"am", "an", "auf", "aus", "bei", "das", "dem", "den", "der", "des", "die", "ein", "eine", "einer", "für", "im", "in", "ist", "mit", "oder", "und", "von", "vom", "wie", "zu", "zum", "zur"},
+	"it": {"a", "al", "alla", "come", "con", "da", "dei", "del", "della", "di", "e", "gli", "i", "il", "in", "la", "le", "lo", "per", "su", "un", "una", "uno"},
+	"pt": {"a", "ao", "as", "com", "como", "da", "das", "de", "do", "dos", "e", "em", "na", "nas", "no", "nos", "o", "os", "para", "por", "que", "um", "uma"},
+	"nl": {"aan", "bij", "de", "een", "en", "het", "hoe", "in", "is", "met", "naar", "of", "op", "te", "van", "voor", "wat"},
+	"ca": {"a", "al", "amb", "com", "de", "del", "el", "els", "en", "és", "i", "la", "les", "o", "per", "que", "un", "una"},
+}
+
+type (
+	CannibalizationServiceRepository interface {
+		FindCannibalizationPageReports(cid int64) []models.PageReport
+		SaveCannibalizationClusters(crawlId int64, clusters []models.CannibalizationCluster) error
+		FindCannibalizationClusters(crawlId int64) []models.CannibalizationCluster
+	}
+
+	CannibalizationService struct {
+		repository CannibalizationServiceRepository
+	}
+)
+
+func NewCannibalizationService(r CannibalizationServiceRepository) *CannibalizationService {
+	return &CannibalizationService{
+		repository: r,
+	}
+}
+
+// UpdateClusters groups the crawl's indexable pages that compete for the same keywords and
+// stores the clusters. It must be called once the crawl's link scores have been stored, as they
+// are used to sort the pages in each cluster.
+func (s *CannibalizationService) UpdateClusters(crawl *models.Crawl) {
+	clusters := CannibalizationClusters(s.repository.FindCannibalizationPageReports(crawl.Id))
+
+	err := s.repository.SaveCannibalizationClusters(crawl.Id, clusters)
+	if err != nil {
+		log.Printf("UpdateClusters: %v\n", err)
+	}
+}
+
+// GetClusters returns the stored clusters of indexable pages in the crawl that compete for the
+// same keywords.
+func (s *CannibalizationService) GetClusters(crawlId int64) []models.CannibalizationCluster {
+	return s.repository.FindCannibalizationClusters(crawlId)
+}
+
+// CannibalizationClusters groups the pages that compete for the same keywords. The titles, H1s
+// and URL slugs of the pages are normalized into a set of terms and the pages in the same language
+// that share most of their terms are added to the same cluster. Only clusters with more than one
+// page are returned, sorted by number of pages. The pages in each cluster are sorted by link score.
+func CannibalizationClusters(pageReports []models.PageReport) []models.CannibalizationCluster {
+	langs := make(map[string][]models.PageReport)
+	for _, p := range pageReports {
+		lang := page.PrimaryLang(p.Lang)
+		langs[lang] = append(langs[lang], p)
+	}
+
+	clusters := []models.CannibalizationCluster{}
+	for lang, l := range langs {
+		clusters = append(clusters, langClusters(l, stopWords(lang))...)
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if len(clusters[i].PageReports) != len(clusters[j].PageReports) {
+			return len(clusters[i].PageReports) > len(clusters[j].PageReports)
+		}
+
+		return clusters[i].PageReports[0].URL < clusters[j].PageReports[0].URL
+	})
+
+	return clusters
+}
+
+// langClusters returns the clusters of the pages in a single language. Candidate pairs are found
+// through an index of the terms, so pages that don't share any term are never compared.
+func langClusters(pageReports []models.PageReport, stop map[string]bool) []models.CannibalizationCluster {
+	terms := make([]map[string]bool, len(pageReports))
+	frequency := make(map[string]int)
+	for i, p := range pageReports {
+		terms[i] = keywordTerms(p, stop)
+		for t := range terms[i] {
+			frequency[t]++
+		}
+	}
+
+	siteWide := max(int(float64(len(pageReports))*cannibalizationSiteWideRatio), cannibalizationSiteWideMin)
+	index := make(map[string][]int)
+	for i := range terms {
+		for t := range terms[i] {
+			if frequency[t] > siteWide {
+				delete(terms[i], t)
+				continue
+			}
+
+			index[t] = append(index[t], i)
+		}
+	}
+
+	parent := make([]int, len(pageReports))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	// find returns the root of the cluster the page belongs to.
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+
+		return i
+	}
+
+	for i := range terms {
+		shared := make(map[int]int)
+		for t := range terms[i] {
+			for _, j := range index[t] {
+				if j > i {
+					shared[j]++
+				}
+			}
+		}
+
+		for j, c := range shared {
+			union := len(terms[i]) + len(terms[j]) - c
+			if c >= cannibalizationMinTerms && float64(c)/float64(union) >= cannibalizationMinOverlap {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	for i := range pageReports {
+		root := find(i)
+		groups[root] = append(groups[root], i)
+	}
+
+	clusters := []models.CannibalizationCluster{}
+	for _, g := range groups {
+		if len(g) < 2 {
+			continue
+		}
+
+		common := make(map[string]bool)
+		for t := range terms[g[0]] {
+			common[t] = true
+		}
+
+		cluster := models.CannibalizationCluster{}
+		for _, i := range g {
+			cluster.PageReports = append(cluster.PageReports, pageReports[i])
+			for t := range common {
+				if !terms[i][t] {
+					delete(common, t)
+				}
+			}
+		}
+
+		for t := range common {
+			cluster.Terms = append(cluster.Terms, t)
+		}
+		sort.Strings(cluster.Terms)
+
+		sort.Slice(cluster.PageReports, func(i, j int) bool {
+			if cluster.PageReports[i].LinkScore != cluster.PageReports[j].LinkScore {
+				return cluster.PageReports[i].LinkScore > cluster.PageReports[j].LinkScore
+			}
+
+			return cluster.PageReports[i].URL < cluster.PageReports[j].URL
+		})
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+// keywordTerms returns the set of terms in the page's title, H1 and the last segment of its URL
+// path. The terms are lowercased words and numbers, without the stop words and single characters.
+func keywordTerms(p models.PageReport, stop map[string]bool) map[string]bool {
+	texts := []string{p.Title, p.H1}
+	if u, err := url.Parse(p.URL); err == nil {
+		slug := path.Base(u.Path)
+		texts = append(texts, strings.TrimSuffix(slug, path.Ext(slug)))
+	}
+
+	terms := make(map[string]bool)
+	for _, text := range texts {
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+
+		for _, w := range words {
+			if utf8.RuneCountInString(w) < 2 || stop[w] {
+				continue
+			}
+
+			terms[w] = true
+		}
+	}
+
+	return terms
+}
+
+// stopWords returns the set of stop words of a language. If the language has no stop words the
+// stop words of all the languages are returned.
+func stopWords(lang string) map[string]bool {
+	stop := make(map[string]bool)
+	for l, words := range cannibalizationStopWords {
+		if _, ok := cannibalizationStopWords[lang]; ok && l != lang {
+			continue
+		}
+
+		for _, w := range words {
+			stop[w] = true
+		}
+	}
+
+	return stop
+}
@@ -0,0 +1,65 @@
+package services_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestCannibalizationClusters(t *testing.T) {
+	pageReports := []models.PageReport{
+		{Id: 1, URL: "https://example.com/red-running-shoes", Title: "Red running shoes | Acme", Lang: "en", LinkScore: 20},
+		{Id: 2, URL: "https://example.com/shoes/red.html", Title: "The best red running shoes | Acme", H1: "Red shoes for running", Lang: "en-US", LinkScore: 40},
+		{Id: 3, URL: "https://example.com/blue-hats", Title: "Blue hats | Acme", Lang: "en", LinkScore: 30},
+		{Id: 4, URL: "https://example.com/es/zapatillas-rojas", Title: "Zapatillas rojas para correr | Acme", Lang: "es", LinkScore: 10},
+		{Id: 5, URL: "https://example.com/es/correr", Title: "Zapatillas rojas de correr | Acme", Lang: "es", LinkScore: 10},
+		{Id: 6, URL: "https://example.com/running-shoes-red", Title: "Rojas running shoes | Acme", Lang: "es", LinkScore: 5},
+	}
+
+	clusters := services.CannibalizationClusters(pageReports)
+	if len(clusters) != 2 {
+		t.Fatalf("CannibalizationClusters: want 2 clusters got %d", len(clusters))
+	}
+
+	table := []struct {
+		ids   []int64
+		terms []string
+	}{
+		{[]int64{5, 4}, []string{"acme", "correr", "rojas", "zapatillas"}},
+		{[]int64{2, 1}, []string{"acme", "red", "running", "shoes"}},
+	}
+
+	for i, v := range table {
+		ids := []int64{}
+		for _, p := range clusters[i].PageReports {
+			ids = append(ids, p.Id)
+		}
+
+		if !reflect.DeepEqual(ids, v.ids) {
+			t.Errorf("CannibalizationClusters cluster %d: want pages %v got %v", i, v.ids, ids)
+		}
+
+		if !reflect.DeepEqual(clusters[i].Terms, v.terms) {
+			t.Errorf("CannibalizationClusters cluster %d: want terms %v got %v", i, v.terms, clusters[i].Terms)
+		}
+	}
+}
+
+func TestCannibalizationClustersSiteWideTerms(t *testing.T) {
+	pageReports := []models.PageReport{}
+	for i := 0; i < 20; i++ {
+		pageReports = append(pageReports, models.PageReport{
+			Id:    int64(i),
+			URL:   "https://example.com/page-" + string(rune('a'+i)),
+			Title: "Acme Store",
+			Lang:  "en",
+		})
+	}
+
+	clusters := services.CannibalizationClusters(pageReports)
+	if len(clusters) != 0 {
+		t.Errorf("CannibalizationClusters: want 0 clusters got %d", len(clusters))
+	}
+}
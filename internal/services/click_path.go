@@ -0,0 +1,88 @@
+package services
+
+import (
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	ClickPathServiceRepository interface {
+		FindPageReportIdByURL(crawlId int64, u string) int64
+		SaveClickPaths(crawlId int64, paths map[int64]models.ClickPathNode) error
+	}
+
+	ClickPathService struct {
+		repository ClickPathServiceRepository
+	}
+)
+
+func NewClickPathService(r ClickPathServiceRepository) *ClickPathService {
+	return &ClickPathService{
+		repository: r,
+	}
+}
+
+// UpdateClickPaths computes the shortest click path from the start URL to each one of the
+// crawl's pages using its internal link graph, and stores the click depth and the previous
+// page in the path.
+func (s *ClickPathService) UpdateClickPaths(crawl *models.Crawl, graph *models.LinkGraph, startURL string) {
+	start := s.repository.FindPageReportIdByURL(crawl.Id, startURL)
+	if start == 0 {
+		log.Printf("UpdateClickPaths: start URL %s not found in crawl %d\n", startURL, crawl.Id)
+		return
+	}
+
+	paths := ClickPaths(graph, start)
+
+	err := s.repository.SaveClickPaths(crawl.Id, paths)
+	if err != nil {
+		log.Printf("UpdateClickPaths: %v\n", err)
+	}
+}
+
+// ClickPaths runs a breadth-first search over the link graph starting at the start page and
+// returns the minimum click depth and previous page of each page that can be reached from it.
+// All links count as a click, including the nofollow ones, while redirects are followed
+// without an extra click. Pages are visited in the order of the links, so the returned path
+// is the first shortest path found.
+func ClickPaths(g *models.LinkGraph, start int64) map[int64]models.ClickPathNode {
+	paths := make(map[int64]models.ClickPathNode)
+
+	outlinks := make(map[int64][]int64)
+	for _, l := range g.Links {
+		if l.To == 0 || l.From == l.To {
+			continue
+		}
+
+		outlinks[l.From] = append(outlinks[l.From], l.To)
+	}
+
+	// Redirects cost no clicks, so the pages are visited using a deque where
+	// the redirect targets are pushed to the front and the linked pages to the back.
+	paths[start] = models.ClickPathNode{}
+	queue := []int64{start}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		node := paths[id]
+
+		if to, ok := g.Redirects[id]; ok {
+			if n, visited := paths[to]; !visited || n.Depth > node.Depth {
+				paths[to] = models.ClickPathNode{Depth: node.Depth, Parent: id}
+				queue = append([]int64{to}, queue...)
+			}
+		}
+
+		for _, to := range outlinks[id] {
+			if _, visited := paths[to]; visited {
+				continue
+			}
+
+			paths[to] = models.ClickPathNode{Depth: node.Depth + 1, Parent: id}
+			queue = append(queue, to)
+		}
+	}
+
+	return paths
+}
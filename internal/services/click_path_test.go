@@ -0,0 +1,51 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestClickPaths(t *testing.T) {
+	// 1 links to 2 and 3, 2 links to 4 and 5, 3 redirects to 5, 5 links to 6.
+	// Page 7 is not linked and 4 has a nofollow link back to 1.
+	g := &models.LinkGraph{
+		Pages: []int64{1, 2, 3, 4, 5, 6, 7},
+		Links: []models.LinkGraphEdge{
+			{From: 1, To: 2},
+			{From: 1, To: 3},
+			{From: 1, To: 0},
+			{From: 2, To: 4},
+			{From: 2, To: 5},
+			{From: 4, To: 1, NoFollow: true},
+			{From: 5, To: 6},
+		},
+		Redirects: map[int64]int64{3: 5},
+	}
+
+	paths := services.ClickPaths(g, 1)
+
+	want := map[int64]models.ClickPathNode{
+		1: {Depth: 0, Parent: 0},
+		2: {Depth: 1, Parent: 1},
+		3: {Depth: 1, Parent: 1},
+		4: {Depth: 2, Parent: 2},
+		5: {Depth: 1, Parent: 3},
+		6: {Depth: 2, Parent: 5},
+	}
+
+	if len(paths) != len(want) {
+		t.Fatalf("ClickPaths want %d paths Got: %d", len(want), len(paths))
+	}
+
+	for id, n := range want {
+		if paths[id] != n {
+			t.Errorf("ClickPaths page %d want: %+v Got: %+v", id, n, paths[id])
+		}
+	}
+
+	if _, ok := paths[7]; ok {
+		t.Error("ClickPaths page 7 should not be reachable")
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/stjudewashere/seonaut/internal/config"
 	"github.com/stjudewashere/seonaut/internal/issues/multipage"
-	"github.com/stjudewashere/seonaut/internal/issues/page"
 	"github.com/stjudewashere/seonaut/internal/repository"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -16,31 +15,60 @@ import (
 )
 
 type Container struct {
-	Config             *config.Config
-	PubSubBroker       *Broker
-	IssueService       *IssueService
-	ReportService      *ReportService
-	ReportManager      *ReportManager
-	UserService        *UserService
-	DashboardService   *DashboardService
-	ProjectService     *ProjectService
-	ProjectViewService *ProjectViewService
-	ExportService      *Exporter
-	CrawlerService     *CrawlerService
-	Translator         *Translator
-	Renderer           *Renderer
-	CookieSession      *CookieSession
-	ArchiveService     *ArchiveService
-	ReplayService      *ReplayService
-
-	db                   *sql.DB
-	issueRepository      *repository.IssueRepository
-	pageReportRepository *repository.PageReportRepository
-	userRepository       *repository.UserRepository
-	projectRepository    *repository.ProjectRepository
-	exportRepository     *repository.ExportRepository
-	crawlRepository      *repository.CrawlRepository
-	dashboardRepository  *repository.DashboardRepository
+	Config                 *config.Config
+	PubSubBroker           *Broker
+	IssueService           *IssueService
+	ReportService          *ReportService
+	ReportManager          *ReportManager
+	UserService            *UserService
+	DashboardService       *DashboardService
+	ProjectService         *ProjectService
+	ProjectViewService     *ProjectViewService
+	ExportService          *Exporter
+	CrawlerService         *CrawlerService
+	Translator             *Translator
+	Renderer               *Renderer
+	CookieSession          *CookieSession
+	ArchiveService         *ArchiveService
+	ReplayService          *ReplayService
+	ExtractionService      *ExtractionService
+	CustomIssueService     *CustomIssueService
+	ThresholdsService      *IssueThresholdsService
+	IssueTypeService       *IssueTypeSettingService
+	SuppressionService     *IssueSuppressionService
+	WorkflowService        *IssueWorkflowService
+	LinkScoreService       *LinkScoreService
+	StructureService       *SiteStructureService
+	PhraseService          *PhraseService
+	PositionService        *LinkPositionService
+	ClickPathService       *ClickPathService
+	CannibalizationService *CannibalizationService
+	PageWeightService      *PageWeightService
+	BrokenLinkService      *BrokenLinkService
+	StructuredDataService  *StructuredDataService
+
+	db                        *sql.DB
+	issueRepository           *repository.IssueRepository
+	pageReportRepository      *repository.PageReportRepository
+	userRepository            *repository.UserRepository
+	projectRepository         *repository.ProjectRepository
+	exportRepository          *repository.ExportRepository
+	crawlRepository           *repository.CrawlRepository
+	dashboardRepository       *repository.DashboardRepository
+	extractionRepository      *repository.ExtractionRepository
+	customIssueRepository     *repository.CustomIssueRepository
+	thresholdsRepository      *repository.IssueThresholdsRepository
+	issueTypeRepository       *repository.IssueTypeSettingRepository
+	suppressionRepository     *repository.IssueSuppressionRepository
+	workflowRepository        *repository.IssueWorkflowRepository
+	linkScoreRepository       *repository.LinkScoreRepository
+	phraseRepository          *repository.PhraseRepository
+	positionRepository        *repository.LinkPositionRuleRepository
+	clickPathRepository       *repository.ClickPathRepository
+	pageWeightRepository      *repository.PageWeightRepository
+	brokenLinkRepository      *repository.BrokenLinkRepository
+	cannibalizationRepository *repository.CannibalizationRepository
+	structuredDataRepository  *repository.StructuredDataRepository
 }
 
 func NewContainer(configFile string) *Container {
@@ -57,6 +85,21 @@ func NewContainer(configFile string) *Container {
 	c.InitDashboardService()
 	c.InitProjectService()
 	c.InitProjectViewService()
+	c.InitExtractionService()
+	c.InitCustomIssueService()
+	c.InitThresholdsService()
+	c.InitIssueTypeService()
+	c.InitSuppressionService()
+	c.InitWorkflowService()
+	c.InitLinkScoreService()
+	c.InitStructureService()
+	c.InitPhraseService()
+	c.InitLinkPositionService()
+	c.InitClickPathService()
+	c.InitCannibalizationService()
+	c.InitPageWeightService()
+	c.InitBrokenLinkService()
+	c.InitStructuredDataService()
 	c.InitTranslator()
 	c.InitExportService()
 	c.InitCrawlerService()
@@ -108,6 +151,20 @@ func (c *Container) InitRepositories() {
 	c.exportRepository = &repository.ExportRepository{DB: c.db}
 	c.crawlRepository = &repository.CrawlRepository{DB: c.db}
 	c.dashboardRepository = &repository.DashboardRepository{DB: c.db}
+	c.extractionRepository = &repository.ExtractionRepository{DB: c.db}
+	c.customIssueRepository = &repository.CustomIssueRepository{DB: c.db}
+	c.thresholdsRepository = &repository.IssueThresholdsRepository{DB: c.db}
+	c.issueTypeRepository = &repository.IssueTypeSettingRepository{DB: c.db}
+	c.suppressionRepository = &repository.IssueSuppressionRepository{DB: c.db}
+	c.workflowRepository = &repository.IssueWorkflowRepository{DB: c.db}
+	c.linkScoreRepository = &repository.LinkScoreRepository{DB: c.db}
+	c.phraseRepository = &repository.PhraseRepository{DB: c.db}
+	c.positionRepository = &repository.LinkPositionRuleRepository{DB: c.db}
+	c.clickPathRepository = &repository.ClickPathRepository{DB: c.db}
+	c.pageWeightRepository = &repository.PageWeightRepository{DB: c.db}
+	c.brokenLinkRepository = &repository.BrokenLinkRepository{DB: c.db}
+	c.cannibalizationRepository = &repository.CannibalizationRepository{DB: c.db}
+	c.structuredDataRepository = &repository.StructuredDataRepository{DB: c.db}
 
 	// Clean up unfinished crawls.
 	c.crawlRepository.DeleteUnfinishedCrawls()
@@ -136,12 +193,10 @@ func (c *Container) InitReportService() {
 	c.ReportService = NewReportService(repository)
 }
 
-// Create the report manager and add all the available reporters.
+// Create the report manager and add the multipage reporters. The page reporters depend on
+// each project's settings, so they are added to a copy of the report manager in each crawl.
 func (c *Container) InitReportManager() {
 	c.ReportManager = NewReportManager(c.issueRepository)
-	for _, r := range page.GetAllReporters() {
-		c.ReportManager.AddPageReporter(r)
-	}
 
 	// Create the sql multipage reporters and add them all to the reporterManager.
 	sqlReporters := multipage.NewSqlReporter(c.db)
@@ -186,6 +241,91 @@ func (c *Container) InitProjectViewService() {
 	c.ProjectViewService = NewProjectViewService(repository)
 }
 
+// Create the extraction rules service.
+func (c *Container) InitExtractionService() {
+	c.ExtractionService = NewExtractionService(c.extractionRepository)
+}
+
+// Create the custom issue rules service.
+func (c *Container) InitCustomIssueService() {
+	c.CustomIssueService = NewCustomIssueService(c.customIssueRepository)
+}
+
+// Create the issue thresholds service.
+func (c *Container) InitThresholdsService() {
+	c.ThresholdsService = NewIssueThresholdsService(c.thresholdsRepository)
+}
+
+// Create the issue type settings service.
+func (c *Container) InitIssueTypeService() {
+	c.IssueTypeService = NewIssueTypeSettingService(c.issueTypeRepository)
+}
+
+// Create the issue suppressions service.
+func (c *Container) InitSuppressionService() {
+	repository := &struct {
+		*repository.IssueSuppressionRepository
+		*repository.CrawlRepository
+		*repository.IssueRepository
+	}{
+		c.suppressionRepository,
+		c.crawlRepository,
+		c.issueRepository,
+	}
+
+	c.SuppressionService = NewIssueSuppressionService(repository)
+}
+
+// Create the issue workflow service.
+func (c *Container) InitWorkflowService() {
+	c.WorkflowService = NewIssueWorkflowService(c.workflowRepository)
+}
+
+// Create the link score service.
+func (c *Container) InitLinkScoreService() {
+	c.LinkScoreService = NewLinkScoreService(c.linkScoreRepository)
+}
+
+// Create the site structure service.
+func (c *Container) InitStructureService() {
+	c.StructureService = NewSiteStructureService(c.pageReportRepository)
+}
+
+// Create the phrase service for the generic anchors and soft 404 patterns.
+func (c *Container) InitPhraseService() {
+	c.PhraseService = NewPhraseService(c.phraseRepository)
+}
+
+// Create the link position service.
+func (c *Container) InitLinkPositionService() {
+	c.PositionService = NewLinkPositionService(c.positionRepository)
+}
+
+// Create the click path service.
+func (c *Container) InitClickPathService() {
+	c.ClickPathService = NewClickPathService(c.clickPathRepository)
+}
+
+// Create the keyword cannibalization service.
+func (c *Container) InitCannibalizationService() {
+	c.CannibalizationService = NewCannibalizationService(c.cannibalizationRepository)
+}
+
+// Create the page weight service.
+func (c *Container) InitPageWeightService() {
+	c.PageWeightService = NewPageWeightService(c.pageWeightRepository)
+}
+
+// Create the broken link service.
+func (c *Container) InitBrokenLinkService() {
+	c.BrokenLinkService = NewBrokenLinkService(c.brokenLinkRepository)
+}
+
+// Create the structured data service.
+func (c *Container) InitStructuredDataService() {
+	c.StructuredDataService = NewStructuredDataService(c.structuredDataRepository)
+}
+
 // Create the Export service.
 func (c *Container) InitExportService() {
 	c.ExportService = NewExporter(c.exportRepository, c.Translator)
@@ -193,19 +333,42 @@ func (c *Container) InitExportService() {
 
 // Create Crawler service.
 func (c *Container) InitCrawlerService() {
+	crawlerHandlerRepository := &struct {
+		*repository.PageReportRepository
+		*repository.ExtractionRepository
+		*repository.CustomIssueRepository
+		*repository.IssueThresholdsRepository
+		*repository.IssueTypeSettingRepository
+		*repository.PhraseRepository
+		*repository.LinkPositionRuleRepository
+	}{
+		c.pageReportRepository,
+		c.extractionRepository,
+		c.customIssueRepository,
+		c.thresholdsRepository,
+		c.issueTypeRepository,
+		c.phraseRepository,
+		c.positionRepository,
+	}
 	crawlerServices := CrawlerServicesContainer{
-		Broker:         c.PubSubBroker,
-		ReportManager:  c.ReportManager,
-		CrawlerHandler: NewCrawlerHandler(c.pageReportRepository, c.PubSubBroker, c.ReportManager),
-		ArchiveService: c.ArchiveService,
-		Config:         c.Config.Crawler,
+		Broker:                 c.PubSubBroker,
+		CrawlerHandler:         NewCrawlerHandler(crawlerHandlerRepository, c.PubSubBroker, c.ReportManager, multipage.NewSqlReporter(c.db)),
+		ArchiveService:         c.ArchiveService,
+		LinkScoreService:       c.LinkScoreService,
+		ClickPathService:       c.ClickPathService,
+		PageWeightService:      c.PageWeightService,
+		BrokenLinkService:      c.BrokenLinkService,
+		CannibalizationService: c.CannibalizationService,
+		Config:                 c.Config.Crawler,
 	}
 	repository := &struct {
 		*repository.CrawlRepository
 		*repository.IssueRepository
+		*repository.IssueWorkflowRepository
 	}{
 		c.crawlRepository,
 		c.issueRepository,
+		c.workflowRepository,
 	}
 
 	c.CrawlerService = NewCrawlerService(repository, crawlerServices)
@@ -29,37 +29,50 @@ type CrawlerServiceRepository interface {
 
 	CountIssuesByPriority(int64, int) int
 	UpdateCrawl(*models.Crawl)
+	UpdateIssueWorkflows(*models.Crawl)
 }
 
 type CrawlerServicesContainer struct {
-	Broker         *Broker
-	ReportManager  *ReportManager
-	CrawlerHandler *CrawlerHandler
-	ArchiveService *ArchiveService
-	Config         *config.CrawlerConfig
+	Broker                 *Broker
+	CrawlerHandler         *CrawlerHandler
+	ArchiveService         *ArchiveService
+	LinkScoreService       *LinkScoreService
+	ClickPathService       *ClickPathService
+	PageWeightService      *PageWeightService
+	BrokenLinkService      *BrokenLinkService
+	CannibalizationService *CannibalizationService
+	Config                 *config.CrawlerConfig
 }
 
 type CrawlerService struct {
-	repository     CrawlerServiceRepository
-	config         *config.CrawlerConfig
-	broker         *Broker
-	reportManager  *ReportManager
-	crawlerHandler *CrawlerHandler
-	ArchiveService *ArchiveService
-	crawlers       map[int64]*crawler.Crawler
-	lock           *sync.RWMutex
+	repository             CrawlerServiceRepository
+	config                 *config.CrawlerConfig
+	broker                 *Broker
+	crawlerHandler         *CrawlerHandler
+	ArchiveService         *ArchiveService
+	linkScoreService       *LinkScoreService
+	clickPathService       *ClickPathService
+	pageWeightService      *PageWeightService
+	brokenLinkService      *BrokenLinkService
+	cannibalizationService *CannibalizationService
+	crawlers               map[int64]*crawler.Crawler
+	lock                   *sync.RWMutex
 }
 
 func NewCrawlerService(r CrawlerServiceRepository, s CrawlerServicesContainer) *CrawlerService {
 	return &CrawlerService{
-		repository:     r,
-		broker:         s.Broker,
-		config:         s.Config,
-		reportManager:  s.ReportManager,
-		crawlerHandler: s.CrawlerHandler,
-		ArchiveService: s.ArchiveService,
-		crawlers:       make(map[int64]*crawler.Crawler),
-		lock:           &sync.RWMutex{},
+		repository:             r,
+		broker:                 s.Broker,
+		config:                 s.Config,
+		crawlerHandler:         s.CrawlerHandler,
+		ArchiveService:         s.ArchiveService,
+		linkScoreService:       s.LinkScoreService,
+		clickPathService:       s.ClickPathService,
+		pageWeightService:      s.PageWeightService,
+		brokenLinkService:      s.BrokenLinkService,
+		cannibalizationService: s.CannibalizationService,
+		crawlers:               make(map[int64]*crawler.Crawler),
+		lock:                   &sync.RWMutex{},
 	}
 }
 
@@ -92,7 +105,11 @@ func (s *CrawlerService) StartCrawler(p models.Project, b models.BasicAuth) erro
 		defer s.removeCrawler(&p)
 		defer s.repository.DeleteCrawlData(&previousCrawl)
 
-		callback := s.crawlerHandler.responseCallback(crawl, &p, c)
+		// The report manager contains the issue reporters configured in the project.
+		// The response of a URL that doesn't exist is used to detect soft 404 pages.
+		notFound := fetchNotFoundReference(c.Client, u)
+		reportManager := s.crawlerHandler.projectReportManager(&p, notFound)
+		callback := s.crawlerHandler.responseCallback(crawl, &p, c, reportManager)
 
 		if p.Archive {
 			archiver, err := s.ArchiveService.GetArchiveWriter(&p)
@@ -119,7 +136,17 @@ func (s *CrawlerService) StartCrawler(p models.Project, b models.BasicAuth) erro
 		crawl.End = time.Now()
 
 		s.broker.Publish(fmt.Sprintf("crawl-%d", p.Id), &models.Message{Name: "IssuesInit"})
-		s.reportManager.CreateMultipageIssues(crawl)
+
+		// The link scores, click paths and page weights are computed once the link graph
+		// and the resources are complete so they can be used by the multipage issue reporters.
+		// The link graph is loaded once and used by both the link scores and click paths.
+		graph := s.linkScoreService.GetLinkGraph(crawl)
+		s.linkScoreService.UpdateLinkScores(crawl, graph)
+		s.clickPathService.UpdateClickPaths(crawl, graph, u.String())
+		s.pageWeightService.UpdatePageWeights(crawl)
+		s.brokenLinkService.UpdateBrokenLinks(crawl)
+		s.cannibalizationService.UpdateClusters(crawl)
+		reportManager.CreateMultipageIssues(crawl)
 
 		crawl.IssuesEnd = time.Now()
 		crawl.CriticalIssues = s.repository.CountIssuesByPriority(crawl.Id, Critical)
@@ -128,6 +155,13 @@ func (s *CrawlerService) StartCrawler(p models.Project, b models.BasicAuth) erro
 		crawl.TotalIssues = crawl.CriticalIssues + crawl.AlertIssues + crawl.WarningIssues
 
 		s.repository.UpdateCrawl(crawl)
+
+		// Issue types that are no longer reported are verified as fixed. Only completed crawls
+		// are used, as a stopped or partial crawl may not include the pages with the issues.
+		if c.Completed() {
+			s.repository.UpdateIssueWorkflows(crawl)
+		}
+
 		s.broker.Publish(fmt.Sprintf("crawl-%d", p.Id), &models.Message{Name: "CrawlEnd", Data: crawl.TotalURLs})
 		log.Printf("Crawled %d urls in %s", crawl.TotalURLs, p.URL)
 	}()
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -9,24 +10,41 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/antchfx/htmlquery"
 	"github.com/stjudewashere/seonaut/internal/crawler"
+	"github.com/stjudewashere/seonaut/internal/issues/multipage"
+	"github.com/stjudewashere/seonaut/internal/issues/page"
 	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/urlutils"
 	"golang.org/x/net/html"
 )
 
+// Regular expression to extract the urls in the CSS url() functions.
+var cssURLRegex = regexp.MustCompile(`url\((.*?)\)`)
+
+// File extensions of the font files referenced in the CSS url() functions.
+var fontExtensions = []string{".woff2", ".woff", ".ttf", ".otf", ".eot"}
+
 type CrawlerHandlerRepository interface {
 	SavePageReport(*models.PageReport, int64) (*models.PageReport, error)
+	FindExtractionRules(projectId int64) []models.ExtractionRule
+	FindCustomIssueRules(projectId int64) []models.CustomIssueRule
+	FindIssueThresholds(projectId int64) (models.IssueThresholds, error)
+	FindDisabledIssueTypes(projectId int64) []int
+	FindPhrases(projectId int64, kind string) []models.Phrase
+	FindLinkPositionRules(projectId int64) []models.LinkPositionRule
 }
 
 type CrawlerHandler struct {
 	repository          CrawlerHandlerRepository
 	broker              *Broker
 	reportManager       *ReportManager
+	sqlReporter         *multipage.SqlReporter
 	externalLinksStatus map[string]int
 }
 
@@ -38,11 +56,12 @@ type Archiver interface {
 	AddRecord(*http.Response)
 }
 
-func NewCrawlerHandler(r CrawlerHandlerRepository, b *Broker, m *ReportManager) *CrawlerHandler {
+func NewCrawlerHandler(r CrawlerHandlerRepository, b *Broker, m *ReportManager, sr *multipage.SqlReporter) *CrawlerHandler {
 	return &CrawlerHandler{
 		repository:          r,
 		broker:              b,
 		reportManager:       m,
+		sqlReporter:         sr,
 		externalLinksStatus: make(map[string]int),
 	}
 }
@@ -56,7 +75,10 @@ func (s *CrawlerHandler) archiveWrapper(callback crawler.ResponseCallback, a Arc
 	}
 }
 
-func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project, c *crawler.Crawler) crawler.ResponseCallback {
+func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project, c *crawler.Crawler, reportManager *ReportManager) crawler.ResponseCallback {
+	extractionRules := s.repository.FindExtractionRules(p.Id)
+	linkPositionRules := s.repository.FindLinkPositionRules(p.Id)
+
 	return func(r *crawler.ResponseMessage) {
 		pageReport, htmlNode, err := s.buildPageReport(r)
 		if err != nil {
@@ -75,11 +97,17 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 		}
 
 		pageReport.TTFB = r.TTFB
+		pageReport.TransferSize = r.TransferSize
 		pageReport.Depth = d.Depth
 		pageReport.BlockedByRobotstxt = r.Blocked
 		pageReport.InSitemap = r.InSitemap
 		pageReport.Crawled = !pageReport.Timeout && (p.FollowNofollow || !pageReport.Nofollow)
 
+		// Override the position of the links matched by the project's link position rules.
+		if len(linkPositionRules) > 0 && pageReport.MediaType == "text/html" && htmlNode.Type != html.ErrorNode {
+			ApplyLinkPositionRules(linkPositionRules, pageReport, htmlNode)
+		}
+
 		// Add link URLs to the crawler considering the nofollow attribute as well as
 		// the projects FollowNoFollow option. In case the URL is blocked by the robots.txt
 		// file a new blocked PageReport is saved. Both internal and external links
@@ -146,7 +174,7 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 			}
 
 			for _, st := range styleTags {
-				cssURLs = append(cssURLs, s.ExtractURLsFromCSS(htmlquery.InnerText(st))...)
+				cssURLs = append(cssURLs, ExtractURLsFromCSS(htmlquery.InnerText(st))...)
 			}
 
 			// Extract urls from inline css
@@ -158,7 +186,7 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 			for _, inlineStyleElement := range inlineStyleElements {
 				for _, attr := range inlineStyleElement.Attr {
 					if attr.Key == "style" {
-						cssURLs = append(cssURLs, s.ExtractURLsFromCSS(attr.Val)...)
+						cssURLs = append(cssURLs, ExtractURLsFromCSS(attr.Val)...)
 					}
 				}
 			}
@@ -170,9 +198,15 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 			if err != nil {
 				log.Printf("failed to read response body: %v", err)
 			}
-			cssURLs = append(cssURLs, s.ExtractURLsFromCSS(string(body))...)
+			cssURLs = append(cssURLs, ExtractURLsFromCSS(string(body))...)
 		}
 
+		// The CSS url() references loaded with the http scheme in https pages are passive mixed content.
+		pageReport.MixedContent = append(pageReport.MixedContent, cssMixedContent(pageReport.ParsedURL, cssURLs)...)
+
+		// The font files referenced in the CSS url() functions are added to the page's fonts.
+		pageReport.Fonts = cssFonts(pageReport.ParsedURL, pageReport.Fonts, cssURLs)
+
 		// Add the extracted urls to the crawler's queue
 		for _, u := range cssURLs {
 			u = pageReport.ParsedURL.ResolveReference(u)
@@ -188,6 +222,16 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 			}
 		}
 
+		// Evaluate the project's extraction rules in the html pages.
+		if len(extractionRules) > 0 && pageReport.MediaType == "text/html" && r.Response != nil && htmlNode.Type != html.ErrorNode {
+			body, err := io.ReadAll(r.Response.Body)
+			if err != nil {
+				log.Printf("failed to read response body: %v", err)
+			}
+			r.Response.Body = io.NopCloser(bytes.NewReader(body))
+			pageReport.Extractions = Extract(extractionRules, htmlNode, body)
+		}
+
 		// Check the external links if the project is set to do so.
 		if p.CheckExternalLinks {
 			s.checkExternalLinks(c.Client, pageReport)
@@ -204,7 +248,7 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 				if r.Response != nil {
 					headers = r.Response.Header
 				}
-				s.reportManager.CreatePageIssues(pageReport, htmlNode, &headers, crawl)
+				reportManager.CreatePageIssues(pageReport, htmlNode, &headers, crawl)
 			} else {
 				log.Printf("crawler service: SavePageReport: %v\n", err)
 			}
@@ -223,6 +267,41 @@ func (s *CrawlerHandler) responseCallback(crawl *models.Crawl, p *models.Project
 	}
 }
 
+// projectReportManager returns a copy of the report manager with the project's page reporters.
+// The page reporters and the multipage reporters that depend on the project's settings are
+// created using the project's issue thresholds, and the project's custom
+// issue reporters are added as well, so they only run in the project's crawl. The soft 404
+// reporter compares the pages with the notFound reference of the site's not found page. The
+// issue types disabled in the project are disabled in the report manager.
+func (s *CrawlerHandler) projectReportManager(p *models.Project, notFound models.NotFoundReference) *ReportManager {
+	reportManager := s.reportManager.Copy()
+
+	thresholds := projectThresholds(s.repository.FindIssueThresholds, p.Id)
+	for _, r := range page.GetAllReporters(thresholds) {
+		reportManager.AddPageReporter(r)
+	}
+
+	for _, r := range s.sqlReporter.GetThresholdReporters(thresholds) {
+		reportManager.AddMultipageReporter(r)
+	}
+
+	anchors := page.GenericAnchors(s.repository.FindPhrases(p.Id, models.PhraseGenericAnchor))
+	reportManager.AddPageReporter(page.NewGenericAnchorTextReporter(anchors))
+
+	patterns := page.Soft404Patterns(s.repository.FindPhrases(p.Id, models.PhraseSoft404))
+	reportManager.AddPageReporter(page.NewSoft404Reporter(patterns, notFound, thresholds.MinSimilarity))
+
+	for _, rule := range s.repository.FindCustomIssueRules(p.Id) {
+		reportManager.AddPageReporter(page.NewCustomIssueReporter(rule))
+	}
+
+	for _, t := range s.repository.FindDisabledIssueTypes(p.Id) {
+		reportManager.DisableIssueType(t)
+	}
+
+	return reportManager
+}
+
 // buildPageReport builds a PageReport based on the responseMessage checking for Timeout errors.
 func (s *CrawlerHandler) buildPageReport(r *crawler.ResponseMessage) (*models.PageReport, *html.Node, error) {
 	// Check if the response caused an error and save a pageReport.
@@ -402,21 +481,33 @@ func (s *CrawlerHandler) getResourceURLs(p *models.PageReport) []*url.URL {
 		urls = append(urls, t)
 	}
 
+	// Social images may be relative URLs, so they are resolved using the page URL.
+	for _, t := range p.SocialTags {
+		if t.Property != "og:image" && t.Property != "twitter:image" {
+			continue
+		}
+
+		i, err := url.Parse(t.Content)
+		if err != nil || t.Content == "" || p.ParsedURL == nil {
+			continue
+		}
+		urls = append(urls, p.ParsedURL.ResolveReference(i))
+	}
+
 	return urls
 }
 
 // ExtractURLsFromCSS accepts a css string and returns a slice with all the urls
 // it finds in it.
-func (s *CrawlerHandler) ExtractURLsFromCSS(cssContent string) []*url.URL {
+func ExtractURLsFromCSS(cssContent string) []*url.URL {
 	urls := []*url.URL{}
 
-	urlRegex := regexp.MustCompile(`url\((.*?)\)`)
-	matches := urlRegex.FindAllStringSubmatch(cssContent, -1)
+	matches := cssURLRegex.FindAllStringSubmatch(cssContent, -1)
 
 	for _, match := range matches {
 		if len(match) > 1 {
 			urlStr := match[1]
-			urlStr = strings.Trim(urlStr, "'\"")
+			urlStr = strings.Trim(urlStr, "'\" ")
 			if u, err := url.Parse(urlStr); err == nil {
 				urls = append(urls, u)
 			} else {
@@ -427,3 +518,55 @@ func (s *CrawlerHandler) ExtractURLsFromCSS(cssContent string) []*url.URL {
 
 	return urls
 }
+
+// cssMixedContent returns the passive mixed content in the URLs extracted from the CSS of a page.
+// It returns an empty slice if the page's URL doesn't use the https scheme.
+func cssMixedContent(pageURL *url.URL, cssURLs []*url.URL) []models.MixedContent {
+	mixedContent := []models.MixedContent{}
+	if pageURL.Scheme != "https" {
+		return mixedContent
+	}
+
+	seen := make(map[string]bool)
+	for _, u := range cssURLs {
+		u = pageURL.ResolveReference(u)
+		if u.Scheme != "http" || seen[u.String()] {
+			continue
+		}
+
+		seen[u.String()] = true
+		mixedContent = append(mixedContent, models.MixedContent{
+			URL:     u.String(),
+			Type:    models.MixedContentPassive,
+			Element: "css",
+		})
+	}
+
+	return mixedContent
+}
+
+// cssFonts returns the fonts slice with the font files found in the URLs extracted from the CSS
+// of a page. The font files are identified by their extension and each URL is added only once.
+func cssFonts(pageURL *url.URL, fonts []string, cssURLs []*url.URL) []string {
+	seen := make(map[string]bool)
+	for _, f := range fonts {
+		seen[f] = true
+	}
+
+	for _, u := range cssURLs {
+		u = pageURL.ResolveReference(u)
+		if u.Scheme != "https" && u.Scheme != "http" {
+			continue
+		}
+
+		ext := strings.ToLower(path.Ext(u.Path))
+		if !slices.Contains(fontExtensions, ext) || seen[u.String()] {
+			continue
+		}
+
+		seen[u.String()] = true
+		fonts = append(fonts, u.String())
+	}
+
+	return fonts
+}
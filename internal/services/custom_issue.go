@@ -0,0 +1,118 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+type (
+	CustomIssueServiceRepository interface {
+		FindCustomIssueRules(projectId int64) []models.CustomIssueRule
+		SaveCustomIssueRule(*models.CustomIssueRule) error
+		DeleteCustomIssueRule(id, projectId int64) error
+	}
+
+	CustomIssueService struct {
+		repository CustomIssueServiceRepository
+	}
+)
+
+var (
+	// Error returned when the custom issue's name is empty.
+	ErrCustomIssueName = errors.New("custom issue name must not be empty")
+
+	// Error returned when the custom issue's priority is not Critical, Alert or Warning.
+	ErrCustomIssuePriority = errors.New("custom issue priority not supported")
+
+	// Error returned when the custom issue's condition is not valid.
+	ErrCustomIssueCondition = errors.New("custom issue condition is not valid")
+)
+
+func NewCustomIssueService(r CustomIssueServiceRepository) *CustomIssueService {
+	return &CustomIssueService{
+		repository: r,
+	}
+}
+
+// GetRules returns the project's custom issue rules.
+func (s *CustomIssueService) GetRules(projectId int64) []models.CustomIssueRule {
+	return s.repository.FindCustomIssueRules(projectId)
+}
+
+// SaveRule validates the custom issue rule and stores it.
+func (s *CustomIssueService) SaveRule(rule *models.CustomIssueRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Subject = strings.TrimSpace(rule.Subject)
+	rule.Value = strings.TrimSpace(rule.Value)
+
+	err := ValidateCustomIssueRule(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SaveCustomIssueRule(rule)
+}
+
+// DeleteRule removes a project's custom issue rule.
+func (s *CustomIssueService) DeleteRule(id, projectId int64) error {
+	return s.repository.DeleteCustomIssueRule(id, projectId)
+}
+
+// ValidateCustomIssueRule checks the rule has a name, a valid priority and a condition that
+// can be evaluated. XPath conditions must have a valid expression and can only check if it
+// matches or not, while the rest of conditions need a subject to take the value from and
+// a value to compare with, unless they check whether the value exists.
+func ValidateCustomIssueRule(rule *models.CustomIssueRule) error {
+	if rule.Name == "" {
+		return ErrCustomIssueName
+	}
+
+	if rule.Priority != Critical && rule.Priority != Alert && rule.Priority != Warning {
+		return ErrCustomIssuePriority
+	}
+
+	if rule.Subject == "" {
+		return ErrCustomIssueCondition
+	}
+
+	switch rule.Source {
+	case models.CustomIssueXPath:
+		if rule.Operator != models.CustomIssueExists && rule.Operator != models.CustomIssueNotExists {
+			return ErrCustomIssueCondition
+		}
+
+		_, err := htmlquery.QueryAll(&html.Node{Type: html.DocumentNode}, rule.Subject)
+		if err != nil {
+			return ErrCustomIssueCondition
+		}
+
+		return nil
+	case models.CustomIssueField:
+		if _, ok := page.CustomIssueFields[rule.Subject]; !ok {
+			return ErrCustomIssueCondition
+		}
+	case models.CustomIssueExtraction, models.CustomIssueHeader:
+	default:
+		return ErrCustomIssueCondition
+	}
+
+	switch rule.Operator {
+	case models.CustomIssueExists, models.CustomIssueNotExists:
+		return nil
+	case models.CustomIssueEquals, models.CustomIssueNotEquals, models.CustomIssueContains,
+		models.CustomIssueNotContains, models.CustomIssueGreater, models.CustomIssueLess:
+		if rule.Value == "" {
+			return ErrCustomIssueCondition
+		}
+
+		return nil
+	}
+
+	return ErrCustomIssueCondition
+}
@@ -0,0 +1,32 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestValidateCustomIssueRule(t *testing.T) {
+	table := []struct {
+		rule models.CustomIssueRule
+		err  error
+	}{
+		{models.CustomIssueRule{Name: "Thin", Priority: services.Warning, Source: models.CustomIssueField, Subject: "words", Operator: models.CustomIssueLess, Value: "300"}, nil},
+		{models.CustomIssueRule{Name: "No cache", Priority: services.Alert, Source: models.CustomIssueHeader, Subject: "X-Cache", Operator: models.CustomIssueNotExists}, nil},
+		{models.CustomIssueRule{Name: "Breadcrumb", Priority: services.Alert, Source: models.CustomIssueXPath, Subject: "//nav", Operator: models.CustomIssueNotExists}, nil},
+		{models.CustomIssueRule{Name: "", Priority: services.Warning, Source: models.CustomIssueField, Subject: "words", Operator: models.CustomIssueLess, Value: "300"}, services.ErrCustomIssueName},
+		{models.CustomIssueRule{Name: "Thin", Priority: 4, Source: models.CustomIssueField, Subject: "words", Operator: models.CustomIssueLess, Value: "300"}, services.ErrCustomIssuePriority},
+		{models.CustomIssueRule{Name: "Thin", Priority: services.Warning, Source: models.CustomIssueField, Subject: "paragraphs", Operator: models.CustomIssueLess, Value: "300"}, services.ErrCustomIssueCondition},
+		{models.CustomIssueRule{Name: "Thin", Priority: services.Warning, Source: models.CustomIssueField, Subject: "words", Operator: models.CustomIssueLess}, services.ErrCustomIssueCondition},
+		{models.CustomIssueRule{Name: "Breadcrumb", Priority: services.Alert, Source: models.CustomIssueXPath, Subject: "//nav[", Operator: models.CustomIssueNotExists}, services.ErrCustomIssueCondition},
+		{models.CustomIssueRule{Name: "Breadcrumb", Priority: services.Alert, Source: models.CustomIssueXPath, Subject: "//nav", Operator: models.CustomIssueContains, Value: "a"}, services.ErrCustomIssueCondition},
+	}
+
+	for _, v := range table {
+		err := services.ValidateCustomIssueRule(&v.rule)
+		if err != v.err {
+			t.Errorf("ValidateCustomIssueRule %s want: %v Got: %v", v.rule.Name, v.err, err)
+		}
+	}
+}
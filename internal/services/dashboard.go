@@ -18,6 +18,7 @@ type (
 		CountScheme(int64) *models.SchemeCount
 		CountByNonCanonical(int64) int
 		GetStatusCodeByDepth(crawlId int64) []models.StatusCodeByDepth
+		CountIssuesByHost(crawlId int64, category string) []models.HostIssues
 	}
 
 	DashboardService struct {
@@ -65,6 +66,12 @@ func (s *DashboardService) GetStatusCodeByDepth(crawlId int64) []models.StatusCo
 	return s.repository.GetStatusCodeByDepth(crawlId)
 }
 
+// GetSecuritySummary returns the number of HTML pages of each crawled host and the number of
+// pages affected by each of the security issue types.
+func (s *DashboardService) GetSecuritySummary(crawlId int64) []models.HostIssues {
+	return s.repository.CountIssuesByHost(crawlId, SecurityCategory)
+}
+
 // Returns a Chart containing the keys and values from the CountList.
 // It limits the slice to the chartLimit value.
 func newChart(c *models.CountList) *models.Chart {
@@ -22,6 +22,11 @@ type (
 		ExportVideos(crawl *models.Crawl) <-chan *models.ExportVideo
 		ExportHreflangs(crawl *models.Crawl) <-chan *models.ExportHreflang
 		ExportIssues(crawl *models.Crawl) <-chan *models.ExportIssue
+		ExportExtractions(crawl *models.Crawl) <-chan *models.ExportExtraction
+		ExportGraphNodes(crawl *models.Crawl) <-chan *models.ExportGraphNode
+		ExportGraphEdges(crawl *models.Crawl) <-chan *models.ExportGraphEdge
+		ExportAnchorTexts(crawl *models.Crawl) <-chan *models.ExportAnchorText
+		ExportBrokenLinks(crawl *models.Crawl) <-chan *models.BrokenLink
 	}
 
 	ExportTranslator interface {
@@ -48,6 +53,7 @@ func (e *Exporter) ExportLinks(f io.Writer, crawl *models.Crawl) {
 		"Origin",
 		"Destination",
 		"Text",
+		"Position",
 	})
 
 	lStream := e.repository.ExportLinks(crawl)
@@ -57,6 +63,63 @@ func (e *Exporter) ExportLinks(f io.Writer, crawl *models.Crawl) {
 			v.Origin,
 			v.Destination,
 			v.Text,
+			v.Position,
+		})
+	}
+
+	w.Flush()
+}
+
+// Export the anchor texts of the internal links as a CSV file
+func (e *Exporter) ExportAnchorTexts(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"Anchor Text",
+		"Links",
+		"Source Pages",
+		"Target URLs",
+	})
+
+	vStream := e.repository.ExportAnchorTexts(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.Text,
+			strconv.Itoa(v.Links),
+			strconv.Itoa(v.Sources),
+			strconv.Itoa(v.Targets),
+		})
+	}
+
+	w.Flush()
+}
+
+// Export the broken links with their source pages as a CSV file
+func (e *Exporter) ExportBrokenLinks(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"Source",
+		"Target",
+		"Text",
+		"Position",
+		"Type",
+		"Status Code",
+		"Source Pages",
+	})
+
+	vStream := e.repository.ExportBrokenLinks(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.SourceURL,
+			v.URL,
+			v.Text,
+			v.Position,
+			v.Type,
+			strconv.Itoa(v.StatusCode),
+			strconv.Itoa(v.Sources),
 		})
 	}
 
@@ -71,6 +134,7 @@ func (e *Exporter) ExportExternalLinks(f io.Writer, crawl *models.Crawl) {
 		"Origin",
 		"Destination",
 		"Text",
+		"Position",
 	})
 
 	lStream := e.repository.ExportExternalLinks(crawl)
@@ -80,6 +144,7 @@ func (e *Exporter) ExportExternalLinks(f io.Writer, crawl *models.Crawl) {
 			v.Origin,
 			v.Destination,
 			v.Text,
+			v.Position,
 		})
 	}
 
@@ -247,6 +312,7 @@ func (e *Exporter) ExportAllIssues(f io.Writer, crawl *models.Crawl) {
 		"URL",
 		"Issue Type",
 		"Priority",
+		"Suppressed",
 	})
 
 	vStream := e.repository.ExportIssues(crawl)
@@ -260,10 +326,40 @@ func (e *Exporter) ExportAllIssues(f io.Writer, crawl *models.Crawl) {
 			priority = "Alert"
 		}
 
+		// Custom issues are exported with their own name.
+		issueType := v.Name
+		if issueType == "" {
+			issueType = e.translator.Trans(v.Type)
+		}
+
 		w.Write([]string{
 			v.Url,
-			e.translator.Trans(v.Type),
+			issueType,
 			priority,
+			strconv.FormatBool(v.Suppressed),
+		})
+	}
+
+	w.Flush()
+}
+
+// Export all the values extracted with the project's extraction rules as a CSV file
+func (e *Exporter) ExportExtractions(f io.Writer, crawl *models.Crawl) {
+	w := csv.NewWriter(f)
+
+	w.Write([]string{
+		"URL",
+		"Extraction",
+		"Value",
+	})
+
+	vStream := e.repository.ExportExtractions(crawl)
+
+	for v := range vStream {
+		w.Write([]string{
+			v.Origin,
+			v.Name,
+			v.Value,
 		})
 	}
 
@@ -290,9 +386,12 @@ func (e *Exporter) ExportPageReports(f io.Writer, prStream <-chan *models.PageRe
 		"Header 1",
 		"Header 2",
 		"Size",
+		"Transfer Size",
 		"Nº of words",
+		"Nº of main content words",
 		"Depth",
 		"TTFB",
+		"Link Score",
 	})
 
 	for r := range prStream {
@@ -311,9 +410,12 @@ func (e *Exporter) ExportPageReports(f io.Writer, prStream <-chan *models.PageRe
 			r.H1,
 			r.H2,
 			fmt.Sprintf("%.1f KB", e.byteToKByte(r.Size)),
+			fmt.Sprintf("%.1f KB", e.byteToKByte(r.TransferSize)),
 			strconv.Itoa(r.Words),
+			strconv.Itoa(r.MainWords),
 			fmt.Sprintf("%d", r.Depth),
 			fmt.Sprintf("%d ms", r.TTFB),
+			fmt.Sprintf("%.1f", r.LinkScore),
 		})
 
 		writer.Flush()
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// ExportGraphML exports the internal link graph as a GraphML file. The nodes are the crawled
+// pages with their status code, depth, indexability and link score, and the edges are the internal
+// links with their nofollow attribute and anchor text.
+func (e *Exporter) ExportGraphML(f io.Writer, crawl *models.Crawl) {
+	w := bufio.NewWriter(f)
+	defer w.Flush()
+
+	fmt.Fprint(w, xml.Header)
+	fmt.Fprintln(w, `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+	fmt.Fprintln(w, `  <key id="url" for="node" attr.name="url" attr.type="string"/>`)
+	fmt.Fprintln(w, `  <key id="status" for="node" attr.name="status" attr.type="int"/>`)
+	fmt.Fprintln(w, `  <key id="depth" for="node" attr.name="depth" attr.type="int"/>`)
+	fmt.Fprintln(w, `  <key id="indexable" for="node" attr.name="indexable" attr.type="boolean"/>`)
+	fmt.Fprintln(w, `  <key id="link_score" for="node" attr.name="link_score" attr.type="double"/>`)
+	fmt.Fprintln(w, `  <key id="nofollow" for="edge" attr.name="nofollow" attr.type="boolean"/>`)
+	fmt.Fprintln(w, `  <key id="anchor" for="edge" attr.name="anchor" attr.type="string"/>`)
+	fmt.Fprintln(w, `  <graph id="G" edgedefault="directed">`)
+
+	for n := range e.repository.ExportGraphNodes(crawl) {
+		fmt.Fprintf(w, "    <node id=\"%d\">\n", n.Id)
+		fmt.Fprintf(w, "      <data key=\"url\">%s</data>\n", escapeXML(n.URL))
+		fmt.Fprintf(w, "      <data key=\"status\">%d</data>\n", n.StatusCode)
+		fmt.Fprintf(w, "      <data key=\"depth\">%d</data>\n", n.Depth)
+		fmt.Fprintf(w, "      <data key=\"indexable\">%t</data>\n", n.Indexable)
+		fmt.Fprintf(w, "      <data key=\"link_score\">%.2f</data>\n", n.LinkScore)
+		fmt.Fprintln(w, "    </node>")
+	}
+
+	i := 0
+	for l := range e.repository.ExportGraphEdges(crawl) {
+		fmt.Fprintf(w, "    <edge id=\"e%d\" source=\"%d\" target=\"%d\">\n", i, l.Source, l.Target)
+		fmt.Fprintf(w, "      <data key=\"nofollow\">%t</data>\n", l.NoFollow)
+		fmt.Fprintf(w, "      <data key=\"anchor\">%s</data>\n", escapeXML(l.Text))
+		fmt.Fprintln(w, "    </edge>")
+		i++
+	}
+
+	fmt.Fprintln(w, "  </graph>")
+	fmt.Fprintln(w, "</graphml>")
+}
+
+// ExportGEXF exports the internal link graph as a GEXF file, which can be opened with Gephi.
+// It contains the same node and edge attributes as the GraphML export.
+func (e *Exporter) ExportGEXF(f io.Writer, crawl *models.Crawl) {
+	w := bufio.NewWriter(f)
+	defer w.Flush()
+
+	fmt.Fprint(w, xml.Header)
+	fmt.Fprintln(w, `<gexf xmlns="http://gexf.net/1.3" version="1.3">`)
+	fmt.Fprintln(w, `  <graph mode="static" defaultedgetype="directed">`)
+	fmt.Fprintln(w, `    <attributes class="node">`)
+	fmt.Fprintln(w, `      <attribute id="status" title="status" type="integer"/>`)
+	fmt.Fprintln(w, `      <attribute id="depth" title="depth" type="integer"/>`)
+	fmt.Fprintln(w, `      <attribute id="indexable" title="indexable" type="boolean"/>`)
+	fmt.Fprintln(w, `      <attribute id="link_score" title="link_score" type="double"/>`)
+	fmt.Fprintln(w, `    </attributes>`)
+	fmt.Fprintln(w, `    <attributes class="edge">`)
+	fmt.Fprintln(w, `      <attribute id="nofollow" title="nofollow" type="boolean"/>`)
+	fmt.Fprintln(w, `      <attribute id="anchor" title="anchor" type="string"/>`)
+	fmt.Fprintln(w, `    </attributes>`)
+
+	fmt.Fprintln(w, "    <nodes>")
+	for n := range e.repository.ExportGraphNodes(crawl) {
+		fmt.Fprintf(w, "      <node id=\"%d\" label=\"%s\">\n", n.Id, escapeXML(n.URL))
+		fmt.Fprintln(w, "        <attvalues>")
+		fmt.Fprintf(w, "          <attvalue for=\"status\" value=\"%d\"/>\n", n.StatusCode)
+		fmt.Fprintf(w, "          <attvalue for=\"depth\" value=\"%d\"/>\n", n.Depth)
+		fmt.Fprintf(w, "          <attvalue for=\"indexable\" value=\"%t\"/>\n", n.Indexable)
+		fmt.Fprintf(w, "          <attvalue for=\"link_score\" value=\"%.2f\"/>\n", n.LinkScore)
+		fmt.Fprintln(w, "        </attvalues>")
+		fmt.Fprintln(w, "      </node>")
+	}
+	fmt.Fprintln(w, "    </nodes>")
+
+	fmt.Fprintln(w, "    <edges>")
+	i := 0
+	for l := range e.repository.ExportGraphEdges(crawl) {
+		fmt.Fprintf(w, "      <edge id=\"%d\" source=\"%d\" target=\"%d\">\n", i, l.Source, l.Target)
+		fmt.Fprintln(w, "        <attvalues>")
+		fmt.Fprintf(w, "          <attvalue for=\"nofollow\" value=\"%t\"/>\n", l.NoFollow)
+		fmt.Fprintf(w, "          <attvalue for=\"anchor\" value=\"%s\"/>\n", escapeXML(l.Text))
+		fmt.Fprintln(w, "        </attvalues>")
+		fmt.Fprintln(w, "      </edge>")
+		i++
+	}
+	fmt.Fprintln(w, "    </edges>")
+
+	fmt.Fprintln(w, "  </graph>")
+	fmt.Fprintln(w, "</gexf>")
+}
+
+// escapeXML returns s escaped so it can be used as XML text or attribute value.
+func escapeXML(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+// graphTestRepository implements the graph export methods of the ExportRepository interface.
+type graphTestRepository struct {
+	services.ExportRepository
+}
+
+func (r *graphTestRepository) ExportGraphNodes(crawl *models.Crawl) <-chan *models.ExportGraphNode {
+	c := make(chan *models.ExportGraphNode)
+	go func() {
+		defer close(c)
+		c <- &models.ExportGraphNode{Id: 1, URL: "https://example.com/?a=1&b=2", StatusCode: 200, Indexable: true, LinkScore: 100}
+		c <- &models.ExportGraphNode{Id: 2, URL: "https://example.com/page", StatusCode: 200, Depth: 1, LinkScore: 10}
+	}()
+
+	return c
+}
+
+func (r *graphTestRepository) ExportGraphEdges(crawl *models.Crawl) <-chan *models.ExportGraphEdge {
+	c := make(chan *models.ExportGraphEdge)
+	go func() {
+		defer close(c)
+		c <- &models.ExportGraphEdge{Source: 1, Target: 2, Text: "<Page> & more"}
+		c <- &models.ExportGraphEdge{Source: 2, Target: 1, NoFollow: true}
+	}()
+
+	return c
+}
+
+func TestExportGraphML(t *testing.T) {
+	exporter := services.NewExporter(&graphTestRepository{}, &TestTranslator{})
+
+	var b bytes.Buffer
+	exporter.ExportGraphML(&b, &models.Crawl{})
+
+	graphml := struct {
+		Nodes []struct {
+			Id   string `xml:"id,attr"`
+			Data []struct {
+				Key   string `xml:"key,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"data"`
+		} `xml:"graph>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+		} `xml:"graph>edge"`
+	}{}
+
+	if err := xml.Unmarshal(b.Bytes(), &graphml); err != nil {
+		t.Fatalf("ExportGraphML invalid xml: %v", err)
+	}
+
+	if len(graphml.Nodes) != 2 {
+		t.Errorf("ExportGraphML nodes want: 2 Got: %d", len(graphml.Nodes))
+	}
+
+	if len(graphml.Edges) != 2 {
+		t.Errorf("ExportGraphML edges want: 2 Got: %d", len(graphml.Edges))
+	}
+
+	if graphml.Nodes[0].Data[0].Value != "https://example.com/?a=1&b=2" {
+		t.Errorf("ExportGraphML url want: https://example.com/?a=1&b=2 Got: %s", graphml.Nodes[0].Data[0].Value)
+	}
+}
+
+func TestExportGEXF(t *testing.T) {
+	exporter := services.NewExporter(&graphTestRepository{}, &TestTranslator{})
+
+	var b bytes.Buffer
+	exporter.ExportGEXF(&b, &models.Crawl{})
+
+	gexf := struct {
+		Nodes []struct {
+			Label string `xml:"label,attr"`
+		} `xml:"graph>nodes>node"`
+		Edges []struct {
+			Source    string `xml:"source,attr"`
+			AttValues []struct {
+				For   string `xml:"for,attr"`
+				Value string `xml:"value,attr"`
+			} `xml:"attvalues>attvalue"`
+		} `xml:"graph>edges>edge"`
+	}{}
+
+	if err := xml.Unmarshal(b.Bytes(), &gexf); err != nil {
+		t.Fatalf("ExportGEXF invalid xml: %v", err)
+	}
+
+	if len(gexf.Nodes) != 2 {
+		t.Errorf("ExportGEXF nodes want: 2 Got: %d", len(gexf.Nodes))
+	}
+
+	if len(gexf.Edges) != 2 {
+		t.Errorf("ExportGEXF edges want: 2 Got: %d", len(gexf.Edges))
+	}
+
+	if gexf.Edges[0].AttValues[1].Value != "<Page> & more" {
+		t.Errorf("ExportGEXF anchor want: <Page> & more Got: %s", gexf.Edges[0].AttValues[1].Value)
+	}
+}
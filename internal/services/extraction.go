@@ -0,0 +1,354 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+type (
+	ExtractionServiceRepository interface {
+		FindExtractionRules(projectId int64) []models.ExtractionRule
+		SaveExtractionRule(*models.ExtractionRule) error
+		DeleteExtractionRule(id, projectId int64) error
+	}
+
+	ExtractionService struct {
+		repository ExtractionServiceRepository
+	}
+)
+
+var (
+	// Error returned when the extraction rule's name is empty.
+	ErrExtractionName = errors.New("extraction rule name must not be empty")
+
+	// Error returned when the extraction rule's type or mode are not supported.
+	ErrExtractionType = errors.New("extraction rule type or mode not supported")
+
+	// Error returned when the extraction rule's expression can't be compiled.
+	ErrExtractionExpression = errors.New("extraction rule expression is not valid")
+)
+
+// Separator used to join the values of the extraction rules in the "all" mode.
+const extractionSeparator = " | "
+
+func NewExtractionService(r ExtractionServiceRepository) *ExtractionService {
+	return &ExtractionService{
+		repository: r,
+	}
+}
+
+// GetRules returns the project's extraction rules.
+func (s *ExtractionService) GetRules(projectId int64) []models.ExtractionRule {
+	return s.repository.FindExtractionRules(projectId)
+}
+
+// SaveRule validates the extraction rule and stores it.
+func (s *ExtractionService) SaveRule(rule *models.ExtractionRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Expression = strings.TrimSpace(rule.Expression)
+
+	err := ValidateExtractionRule(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SaveExtractionRule(rule)
+}
+
+// DeleteRule removes a project's extraction rule.
+func (s *ExtractionService) DeleteRule(id, projectId int64) error {
+	return s.repository.DeleteExtractionRule(id, projectId)
+}
+
+// ValidateExtractionRule checks the rule has a name, a supported type and mode, and that its
+// expression is a valid XPath, CSS selector or regular expression.
+func ValidateExtractionRule(rule *models.ExtractionRule) error {
+	if rule.Name == "" {
+		return ErrExtractionName
+	}
+
+	if rule.Mode != models.ExtractionFirst && rule.Mode != models.ExtractionAll && rule.Mode != models.ExtractionCount {
+		return ErrExtractionType
+	}
+
+	var err error
+	switch rule.Type {
+	case models.ExtractionXPath:
+		_, err = htmlquery.QueryAll(&html.Node{Type: html.DocumentNode}, rule.Expression)
+	case models.ExtractionCSS:
+		_, err = cssToXPath(rule.Expression)
+	case models.ExtractionRegex:
+		_, err = regexp.Compile(rule.Expression)
+	default:
+		return ErrExtractionType
+	}
+
+	if err != nil || rule.Expression == "" {
+		return ErrExtractionExpression
+	}
+
+	return nil
+}
+
+// Extract evaluates the extraction rules against the parsed html document or the raw
+// body in case of regular expressions. It returns an Extraction for each one of the rules,
+// even if nothing was matched, so the count mode returns 0 and the rest an empty value.
+func Extract(rules []models.ExtractionRule, doc *html.Node, body []byte) []models.Extraction {
+	extractions := []models.Extraction{}
+
+	for _, rule := range rules {
+		values, err := extractValues(&rule, doc, body)
+		if err != nil {
+			continue
+		}
+
+		var value string
+		switch rule.Mode {
+		case models.ExtractionCount:
+			value = strconv.Itoa(len(values))
+		case models.ExtractionAll:
+			value = strings.Join(values, extractionSeparator)
+		default:
+			if len(values) > 0 {
+				value = values[0]
+			}
+		}
+
+		extractions = append(extractions, models.Extraction{
+			RuleId: rule.Id,
+			Name:   rule.Name,
+			Value:  value,
+		})
+	}
+
+	return extractions
+}
+
+// extractValues returns all the values matched by the rule's expression.
+// In XPath and CSS rules the value is the text content of the matched nodes. In regular
+// expressions the value is the first capturing group if there's one, or the whole match.
+func extractValues(rule *models.ExtractionRule, doc *html.Node, body []byte) ([]string, error) {
+	values := []string{}
+
+	switch rule.Type {
+	case models.ExtractionRegex:
+		re, err := regexp.Compile(rule.Expression)
+		if err != nil {
+			return values, err
+		}
+
+		for _, m := range re.FindAllSubmatch(body, -1) {
+			if len(m) > 1 {
+				values = append(values, string(m[1]))
+			} else {
+				values = append(values, string(m[0]))
+			}
+		}
+
+		return values, nil
+
+	case models.ExtractionCSS, models.ExtractionXPath:
+		expr := rule.Expression
+		if rule.Type == models.ExtractionCSS {
+			var err error
+			expr, err = cssToXPath(expr)
+			if err != nil {
+				return values, err
+			}
+		}
+
+		if doc == nil || doc.Type == html.ErrorNode {
+			return values, nil
+		}
+
+		nodes, err := htmlquery.QueryAll(doc, expr)
+		if err != nil {
+			return values, err
+		}
+
+		for _, n := range nodes {
+			values = append(values, strings.TrimSpace(htmlquery.InnerText(n)))
+		}
+
+		return values, nil
+	}
+
+	return values, ErrExtractionType
+}
+
+// cssToXPath translates a CSS selector into an XPath expression. It supports the most common
+// selectors: type and universal selectors, ids, classes, attribute selectors with the
+// =, ~=, ^= and *= operators, the descendant and child combinators and selector groups.
+// ex. "div.price > span[itemprop=price]" returns "//div[...]/span[@itemprop='price']"
+func cssToXPath(selector string) (string, error) {
+	groups := []string{}
+	for _, g := range strings.Split(selector, ",") {
+		g = strings.TrimSpace(g)
+		if g == "" {
+			return "", fmt.Errorf("empty css selector in %q", selector)
+		}
+
+		xpath, err := cssSequenceToXPath(g)
+		if err != nil {
+			return "", err
+		}
+
+		groups = append(groups, xpath)
+	}
+
+	return strings.Join(groups, " | "), nil
+}
+
+// cssSequenceToXPath translates a CSS selector without groups into an XPath expression.
+func cssSequenceToXPath(selector string) (string, error) {
+	var xpath strings.Builder
+	axis := "//"
+	i := 0
+
+	for i < len(selector) {
+		switch c := selector[i]; {
+		case c == ' ':
+			i++
+			continue
+		case c == '>':
+			if axis == "/" || xpath.Len() == 0 {
+				return "", fmt.Errorf("unexpected combinator in %q", selector)
+			}
+			axis = "/"
+			i++
+			continue
+		}
+
+		step, n, err := cssCompoundToXPath(selector[i:])
+		if err != nil {
+			return "", err
+		}
+
+		xpath.WriteString(axis + step)
+		axis = "//"
+		i += n
+	}
+
+	if xpath.Len() == 0 || axis == "/" {
+		return "", fmt.Errorf("invalid css selector %q", selector)
+	}
+
+	return xpath.String(), nil
+}
+
+// cssCompoundToXPath translates the compound selector at the start of the string into an
+// XPath location step. It returns the step and the number of bytes consumed.
+func cssCompoundToXPath(s string) (string, int, error) {
+	tag := "*"
+	predicates := []string{}
+	i := 0
+
+	name := func() string {
+		start := i
+		for i < len(s) && (isCSSNameChar(s[i])) {
+			i++
+		}
+		return s[start:i]
+	}
+
+	if i < len(s) && s[i] == '*' {
+		i++
+	} else if t := name(); t != "" {
+		tag = strings.ToLower(t)
+	}
+
+	for i < len(s) && s[i] != ' ' && s[i] != '>' {
+		switch s[i] {
+		case '#':
+			i++
+			id := name()
+			if id == "" {
+				return "", i, fmt.Errorf("invalid id selector in %q", s)
+			}
+			predicates = append(predicates, fmt.Sprintf("@id=%s", xpathLiteral(id)))
+		case '.':
+			i++
+			class := name()
+			if class == "" {
+				return "", i, fmt.Errorf("invalid class selector in %q", s)
+			}
+			predicates = append(predicates, fmt.Sprintf("contains(concat(' ', normalize-space(@class), ' '), %s)", xpathLiteral(" "+class+" ")))
+		case '[':
+			end := strings.IndexByte(s[i:], ']')
+			if end < 0 {
+				return "", i, fmt.Errorf("unclosed attribute selector in %q", s)
+			}
+			predicate, err := cssAttributeToXPath(s[i+1 : i+end])
+			if err != nil {
+				return "", i, err
+			}
+			predicates = append(predicates, predicate)
+			i += end + 1
+		default:
+			return "", i, fmt.Errorf("unsupported css selector %q", s)
+		}
+	}
+
+	step := tag
+	for _, p := range predicates {
+		step += "[" + p + "]"
+	}
+
+	return step, i, nil
+}
+
+// cssAttributeToXPath translates the content of a CSS attribute selector into an XPath predicate.
+func cssAttributeToXPath(s string) (string, error) {
+	for _, op := range []string{"~=", "^=", "*=", "="} {
+		idx := strings.Index(s, op)
+		if idx < 0 {
+			continue
+		}
+
+		attr := strings.TrimSpace(s[:idx])
+		value := strings.Trim(strings.TrimSpace(s[idx+len(op):]), "\"'")
+		if attr == "" {
+			return "", fmt.Errorf("invalid attribute selector %q", s)
+		}
+
+		switch op {
+		case "~=":
+			return fmt.Sprintf("contains(concat(' ', normalize-space(@%s), ' '), %s)", attr, xpathLiteral(" "+value+" ")), nil
+		case "^=":
+			return fmt.Sprintf("starts-with(@%s, %s)", attr, xpathLiteral(value)), nil
+		case "*=":
+			return fmt.Sprintf("contains(@%s, %s)", attr, xpathLiteral(value)), nil
+		default:
+			return fmt.Sprintf("@%s=%s", attr, xpathLiteral(value)), nil
+		}
+	}
+
+	attr := strings.TrimSpace(s)
+	if attr == "" {
+		return "", fmt.Errorf("invalid attribute selector %q", s)
+	}
+
+	return "@" + attr, nil
+}
+
+// xpathLiteral returns the string quoted as an XPath string literal.
+func xpathLiteral(s string) string {
+	if !strings.Contains(s, "'") {
+		return "'" + s + "'"
+	}
+
+	return "\"" + s + "\""
+}
+
+// isCSSNameChar returns true if the character can be part of a CSS identifier.
+func isCSSNameChar(c byte) bool {
+	return c == '-' || c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+
+	"github.com/antchfx/htmlquery"
+)
+
+const extractionBody = `
+<html>
+	<head>
+		<script>gtag('config', 'GTM-ABC123');</script>
+	</head>
+	<body>
+		<div class="product main">
+			<span class="author">Jane Doe</span>
+			<span itemprop="price">10.00</span>
+			<ul class="breadcrumb">
+				<li>Home</li>
+				<li>Products</li>
+			</ul>
+		</div>
+		<span itemprop="price">20.00</span>
+	</body>
+</html>`
+
+func TestExtract(t *testing.T) {
+	doc, err := htmlquery.Parse(strings.NewReader(extractionBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := []struct {
+		rule  models.ExtractionRule
+		value string
+	}{
+		{models.ExtractionRule{Type: models.ExtractionXPath, Expression: "//span[@class='author']", Mode: models.ExtractionFirst}, "Jane Doe"},
+		{models.ExtractionRule{Type: models.ExtractionXPath, Expression: "//span[@itemprop='price']", Mode: models.ExtractionCount}, "2"},
+		{models.ExtractionRule{Type: models.ExtractionCSS, Expression: "div.product > span[itemprop=price]", Mode: models.ExtractionAll}, "10.00"},
+		{models.ExtractionRule{Type: models.ExtractionCSS, Expression: "ul.breadcrumb li", Mode: models.ExtractionAll}, "Home | Products"},
+		{models.ExtractionRule{Type: models.ExtractionCSS, Expression: "#missing", Mode: models.ExtractionCount}, "0"},
+		{models.ExtractionRule{Type: models.ExtractionRegex, Expression: `(GTM-[A-Z0-9]+)`, Mode: models.ExtractionFirst}, "GTM-ABC123"},
+	}
+
+	for _, v := range table {
+		extractions := services.Extract([]models.ExtractionRule{v.rule}, doc, []byte(extractionBody))
+		if len(extractions) != 1 {
+			t.Fatalf("extractions len for %s want: 1 Got: %d", v.rule.Expression, len(extractions))
+		}
+
+		if extractions[0].Value != v.value {
+			t.Errorf("extraction %s want: %s Got: %s", v.rule.Expression, v.value, extractions[0].Value)
+		}
+	}
+}
+
+func TestValidateExtractionRule(t *testing.T) {
+	table := []struct {
+		rule models.ExtractionRule
+		err  error
+	}{
+		{models.ExtractionRule{Name: "Author", Type: models.ExtractionXPath, Expression: "//span", Mode: models.ExtractionFirst}, nil},
+		{models.ExtractionRule{Name: "", Type: models.ExtractionXPath, Expression: "//span", Mode: models.ExtractionFirst}, services.ErrExtractionName},
+		{models.ExtractionRule{Name: "Author", Type: "json", Expression: "//span", Mode: models.ExtractionFirst}, services.ErrExtractionType},
+		{models.ExtractionRule{Name: "Author", Type: models.ExtractionXPath, Expression: "//span[", Mode: models.ExtractionFirst}, services.ErrExtractionExpression},
+		{models.ExtractionRule{Name: "Author", Type: models.ExtractionCSS, Expression: "div >", Mode: models.ExtractionFirst}, services.ErrExtractionExpression},
+		{models.ExtractionRule{Name: "Author", Type: models.ExtractionRegex, Expression: "(", Mode: models.ExtractionFirst}, services.ErrExtractionExpression},
+	}
+
+	for _, v := range table {
+		err := services.ValidateExtractionRule(&v.rule)
+		if err != v.err {
+			t.Errorf("ValidateExtractionRule %s want: %v Got: %v", v.rule.Expression, v.err, err)
+		}
+	}
+}
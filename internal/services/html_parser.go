@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/simhash"
 
 	"golang.org/x/net/html"
 )
@@ -70,6 +71,10 @@ func NewHTMLParser(u *url.URL, status int, headers *http.Header, body []byte, co
 
 	pageReport.MediaType, _, _ = mime.ParseMediaType(pageReport.ContentType)
 
+	if strings.HasPrefix(pageReport.MediaType, "image/") && len(body) > 0 {
+		pageReport.ImageWidth, pageReport.ImageHeight = imageDimensions(body)
+	}
+
 	if pageReport.StatusCode >= http.StatusMultipleChoices && pageReport.StatusCode < http.StatusBadRequest {
 		pageReport.RedirectURL = parser.headersLocation()
 
@@ -95,6 +100,11 @@ func NewHTMLParser(u *url.URL, status int, headers *http.Header, body []byte, co
 		pageReport.Videos = parser.htmlVideos()
 		pageReport.Scripts = parser.htmlScripts()
 		pageReport.Styles = parser.htmlStyles()
+		pageReport.Fonts = parser.htmlFonts()
+		pageReport.StructuredData = parser.structuredData()
+		pageReport.SocialTags = parser.htmlSocialTags()
+		pageReport.MixedContent = parser.htmlMixedContent()
+		pageReport.LoadingFindings = parser.htmlLoadingFindings()
 
 		pictures := parser.htmlPictures()
 		pageReport.Images = append(pageReport.Images, pictures...)
@@ -111,6 +121,20 @@ func NewHTMLParser(u *url.URL, status int, headers *http.Header, body []byte, co
 		bnode := parser.htmlBodyNode()
 		if bnode != nil {
 			pageReport.Words = countWords(bnode)
+
+			// The main content is used in the content checks so the boilerplate
+			// shared by all the pages doesn't distort them.
+			mainText := contentText(mainContentNode(bnode))
+			mainWords := textWords(mainText)
+			pageReport.MainWords = len(mainWords)
+			pageReport.MainTextExcerpt = textExcerpt(mainText)
+			pageReport.SimHash = simhash.Hash(mainWords)
+			if len(mainWords) > 0 {
+				pageReport.MainTextHash, err = hashString([]byte(strings.Join(mainWords, " ")))
+				if err != nil {
+					log.Printf("main text hashString URL: %s\nError %v", u.String(), err)
+				}
+			}
 		}
 
 		pageReport.BodyHash, err = hashString(body)
@@ -3,11 +3,14 @@ package services_test
 import (
 	"fmt"
 	"log"
+	"math/bits"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/stjudewashere/seonaut/internal/models"
 	"github.com/stjudewashere/seonaut/internal/services"
 )
 
@@ -112,6 +115,7 @@ func TestPageReportHTML(t *testing.T) {
 		{want: 7, got: len(pageReport.Images)},
 		{want: 1, got: len(pageReport.Scripts)},
 		{want: 1, got: len(pageReport.Styles)},
+		{want: 1, got: len(pageReport.Fonts)},
 		{want: 1, got: len(pageReport.Iframes)},
 		{want: 3, got: len(pageReport.Audios)},
 		{want: 3, got: len(pageReport.Videos)},
@@ -125,6 +129,7 @@ func TestPageReportHTML(t *testing.T) {
 		{want: "fr", got: pageReport.Hreflangs[0].Lang},
 		{want: "https://example.com/js/app.js", got: pageReport.Scripts[0]},
 		{want: "https://example.com/css/style.css", got: pageReport.Styles[0]},
+		{want: "https://example.com/fonts/font.woff2", got: pageReport.Fonts[0]},
 		{want: "en", got: pageReport.Lang},
 		{want: "Test Page Title", got: pageReport.Title},
 		{want: "Test Page Description", got: pageReport.Description},
@@ -480,6 +485,60 @@ func TestSrcset(t *testing.T) {
 	}
 }
 
+func TestImageAttributes(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(
+		`<html>
+		<head></head>
+		<body>
+			<img src="/a.jpg" width="400px" height="300" srcset="/a-800.jpg 2x">
+			<picture>
+				<source srcset="/b.webp">
+				<img src="/b.jpg">
+			</picture>
+		</body>
+	</html>`)
+	headers := &http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, _, err := services.NewHTMLParser(u, 200, headers, body, int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]struct {
+		width  int
+		height int
+		srcset bool
+	}{
+		"https://example.com/a.jpg":     {400, 300, false},
+		"https://example.com/a-800.jpg": {400, 300, true},
+		"https://example.com/b.webp":    {0, 0, true},
+		"https://example.com/b.jpg":     {0, 0, false},
+	}
+
+	if len(pageReport.Images) != len(want) {
+		t.Fatalf("pagereport images len want: %d Got: %d", len(want), len(pageReport.Images))
+	}
+
+	for _, i := range pageReport.Images {
+		w, ok := want[i.URL]
+		if !ok {
+			t.Errorf("unexpected image %s", i.URL)
+			continue
+		}
+
+		if i.Width != w.width || i.Height != w.height || i.Srcset != w.srcset {
+			t.Errorf("image %s want %dx%d srcset %v got %dx%d srcset %v", i.URL, w.width, w.height, w.srcset, i.Width, i.Height, i.Srcset)
+		}
+	}
+}
+
 func TestEmptyBody(t *testing.T) {
 	u, err := url.Parse(testURL)
 	if err != nil {
@@ -601,3 +660,356 @@ func TestBaseRelativeURL(t *testing.T) {
 		t.Errorf("Link with base URL does not match, got %s", pageReport.ExternalLinks[0].URL)
 	}
 }
+
+func TestStructuredData(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(
+		`<html>
+		<head>
+			<script type="application/ld+json">
+				{"@context": "https://schema.org", "@graph": [
+					{"@type": "Organization", "name": "Example", "url": "https://example.com"},
+					{"@type": "BreadcrumbList"}
+				]}
+			</script>
+			<script type="application/ld+json">{"@type": "Article",</script>
+		</head>
+		<body>
+			<div itemscope itemtype="https://schema.org/Product">
+				<span itemprop="name">Product name</span>
+				<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">
+					<meta itemprop="price" content="10.00">
+				</div>
+			</div>
+			<div vocab="https://schema.org/" typeof="Article">
+				<h1 property="headline">Article headline</h1>
+			</div>
+		</body>
+	</html>`)
+	statusCode := 200
+	headers := &http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, _, err := services.NewHTMLParser(u, statusCode, headers, body, int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pageReport.StructuredData) != 5 {
+		t.Fatalf("pageReport structured data len want: %d Got: %d", 5, len(pageReport.StructuredData))
+	}
+
+	table := []struct {
+		format  string
+		sdType  string
+		missing int
+		invalid bool
+	}{
+		{"json-ld", "Organization", 0, false},
+		{"json-ld", "BreadcrumbList", 1, false},
+		{"json-ld", "", 0, true},
+		{"microdata", "Product", 0, false},
+		{"rdfa", "Article", 2, false},
+	}
+
+	for n, v := range table {
+		sd := pageReport.StructuredData[n]
+		if sd.Format != v.format {
+			t.Errorf("structured data %d format want: %s Got: %s", n, v.format, sd.Format)
+		}
+
+		if sd.Type != v.sdType {
+			t.Errorf("structured data %d type want: %s Got: %s", n, v.sdType, sd.Type)
+		}
+
+		if len(sd.Missing) != v.missing {
+			t.Errorf("structured data %d missing properties want: %d Got: %d", n, v.missing, len(sd.Missing))
+		}
+
+		if (sd.Error != "") != v.invalid {
+			t.Errorf("structured data %d error want: %v Got: %s", n, v.invalid, sd.Error)
+		}
+	}
+
+	product := pageReport.StructuredData[3]
+	if product.Properties["name"] != "Product name" {
+		t.Errorf("microdata product name want: Product name Got: %v", product.Properties["name"])
+	}
+
+	offer, ok := product.Properties["offers"].(map[string]interface{})
+	if !ok || offer["price"] != "10.00" {
+		t.Errorf("microdata product offers price want: 10.00 Got: %v", product.Properties["offers"])
+	}
+}
+
+func TestSocialTags(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	body := []byte(
+		`<html>
+		<head>
+			<meta property="og:title" content="Open Graph Title">
+			<meta property="og:image" content="/image.jpg">
+			<meta name="twitter:card" content="summary">
+			<meta name="description" content="Description">
+		</head>
+		<body></body>
+	</html>`)
+	statusCode := 200
+	headers := &http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, _, err := services.NewHTMLParser(u, statusCode, headers, body, int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := []struct {
+		property string
+		content  string
+	}{
+		{"og:title", "Open Graph Title"},
+		{"og:image", "/image.jpg"},
+		{"twitter:card", "summary"},
+	}
+
+	if len(pageReport.SocialTags) != len(tags) {
+		t.Fatalf("pageReport social tags len want: %d Got: %d", len(tags), len(pageReport.SocialTags))
+	}
+
+	for n, v := range tags {
+		if pageReport.SocialTags[n].Property != v.property || pageReport.SocialTags[n].Content != v.content {
+			t.Errorf("social tag %d want: %s %s Got: %v", n, v.property, v.content, pageReport.SocialTags[n])
+		}
+	}
+}
+
+// Test the resources loaded with the http scheme are reported as active or passive mixed content.
+func TestMixedContent(t *testing.T) {
+	u, err := url.Parse("https://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(
+		`<html>
+		<head>
+			<script src="http://example.com/app.js"></script>
+			<script src="/secure.js"></script>
+			<link rel="preload stylesheet" href="http://example.com/style.css">
+		</head>
+		<body>
+			<iframe src="http://example.com/frame"></iframe>
+			<form action="http://example.com/search"></form>
+			<img src="http://example.com/image.jpg" srcset="https://example.com/image.jpg 1x, http://example.com/image-2x.jpg 2x">
+			<video src="http://example.com/video.mp4" poster="http://example.com/poster.jpg"></video>
+			<audio><source src="http://example.com/audio.ogg"></audio>
+		</body>
+	</html>`)
+	headers := &http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, _, err := services.NewHTMLParser(u, 200, headers, body, int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.MixedContent{
+		{URL: "http://example.com/app.js", Type: models.MixedContentActive, Element: "script"},
+		{URL: "http://example.com/style.css", Type: models.MixedContentActive, Element: "stylesheet"},
+		{URL: "http://example.com/frame", Type: models.MixedContentActive, Element: "iframe"},
+		{URL: "http://example.com/search", Type: models.MixedContentActive, Element: "form"},
+		{URL: "http://example.com/image.jpg", Type: models.MixedContentPassive, Element: "img"},
+		{URL: "http://example.com/image-2x.jpg", Type: models.MixedContentPassive, Element: "srcset"},
+		{URL: "http://example.com/video.mp4", Type: models.MixedContentPassive, Element: "video"},
+		{URL: "http://example.com/audio.ogg", Type: models.MixedContentPassive, Element: "audio"},
+		{URL: "http://example.com/poster.jpg", Type: models.MixedContentPassive, Element: "poster"},
+	}
+
+	if len(pageReport.MixedContent) != len(want) {
+		t.Fatalf("pageReport mixed content len want: %d Got: %d %v", len(want), len(pageReport.MixedContent), pageReport.MixedContent)
+	}
+
+	for n, v := range want {
+		if pageReport.MixedContent[n] != v {
+			t.Errorf("mixed content %d want: %v Got: %v", n, v, pageReport.MixedContent[n])
+		}
+	}
+}
+
+func TestLoadingFindings(t *testing.T) {
+	u, err := url.Parse("https://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := []byte(
+		`<html>
+		<head>
+			<script src="/blocking.js"></script>
+			<script src="/async.js" async></script>
+			<script src="/defer.js" defer></script>
+			<script src="/module.js" type="module"></script>
+			<link rel="stylesheet" href="/1.css">
+			<link rel="stylesheet" href="/2.css">
+			<link rel="stylesheet" href="/3.css">
+			<link rel="stylesheet" href="/4.css">
+			<link rel="stylesheet" href="/5.css">
+			<link rel="stylesheet" href="/print.css" media="print">
+			<link rel="preload" href="/blocking.js" as="script">
+			<link rel="preload" href="/unused.js" as="script">
+			<link rel="preload" href="/font.woff2" as="font" crossorigin>
+			<link rel="preload" href="/hero.jpg" as="image">
+			<style>.bg { background: url('/background.jpg'); }</style>
+			<link rel="preload" href="/background.jpg" as="image">
+		</head>
+		<body>
+			<header><img src="/logo.png" loading="lazy"></header>
+			<img src="/icon.png" width="32" height="32">
+			<img src="/hero.jpg" width="1200" height="600" loading="lazy">
+			<img src="/pixel.gif" width="1" height="1">
+			<img src="/2.jpg">
+			<img src="/3.jpg">
+			<img src="/4.jpg">
+			<img src="/5.jpg" loading="lazy">
+			<img srcset="/6.jpg 1x, /6-2x.jpg 2x">
+		</body>
+	</html>`)
+	headers := &http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	pageReport, _, err := services.NewHTMLParser(u, 200, headers, body, int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []models.LoadingFinding{
+		{URL: "https://example.com/blocking.js", Type: models.LoadingBlockingScript, Element: "script"},
+		{URL: "https://example.com/1.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		{URL: "https://example.com/2.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		{URL: "https://example.com/3.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		{URL: "https://example.com/4.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		{URL: "https://example.com/5.css", Type: models.LoadingHeadStylesheet, Element: "stylesheet"},
+		{URL: "https://example.com/unused.js", Type: models.LoadingUnusedPreload, Element: "preload"},
+		{URL: "https://example.com/hero.jpg", Type: models.LoadingLazyLCPImage, Element: "img"},
+		{URL: "https://example.com/4.jpg", Type: models.LoadingEagerImage, Element: "img"},
+		{URL: "https://example.com/6.jpg", Type: models.LoadingEagerImage, Element: "img"},
+	}
+
+	if len(pageReport.LoadingFindings) != len(want) {
+		t.Fatalf("pageReport loading findings len want: %d Got: %d %v", len(want), len(pageReport.LoadingFindings), pageReport.LoadingFindings)
+	}
+
+	for n, v := range want {
+		if pageReport.LoadingFindings[n] != v {
+			t.Errorf("loading finding %d want: %v Got: %v", n, v, pageReport.LoadingFindings[n])
+		}
+	}
+}
+
+// Test the SimHash of pages with similar text differs in less bits than
+// the SimHash of pages with different text.
+func TestSimHash(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	text := "The quick brown fox jumps over the lazy dog while the farmer watches from the old wooden fence near the river bank."
+	bodies := []string{
+		"<html><body><p>" + text + " Updated on Monday.</p><a href=\"/\">Home</a></body></html>",
+		"<html><body><p>" + text + " Updated on Friday.</p><a href=\"/about\">About us</a></body></html>",
+		"<html><body><p>Our online store sells handmade ceramic mugs, plates and bowls shipped worldwide with free returns.</p></body></html>",
+	}
+
+	hashes := []uint64{}
+	for _, b := range bodies {
+		pageReport, _, err := services.NewHTMLParser(u, 200, &headers, []byte(b), int64(len(b)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if pageReport.SimHash == 0 {
+			t.Fatalf("SimHash: empty hash for %s", b)
+		}
+
+		hashes = append(hashes, pageReport.SimHash)
+	}
+
+	similar := bits.OnesCount64(hashes[0] ^ hashes[1])
+	different := bits.OnesCount64(hashes[0] ^ hashes[2])
+	if similar >= different {
+		t.Errorf("SimHash: similar pages differ in %d bits, different pages in %d bits", similar, different)
+	}
+}
+
+// Test the main content is extracted without the boilerplate elements, so pages that
+// only differ in their boilerplate have the same main content.
+func TestMainContent(t *testing.T) {
+	u, err := url.Parse(testURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := http.Header{
+		"Content-Type": []string{"text/html"},
+	}
+
+	content := `
+		<div class="post">
+			<h1>Main content title</h1>
+			<p>This is the first paragraph of the main content, with enough text to be scored.</p>
+			<p>This is the second paragraph, which also has some text, commas, and a <a href="/link">link</a>.</p>
+		</div>`
+
+	bodies := []string{
+		`<html><body>
+			<header><p>Site header with a long enough tagline to be scored as a paragraph.</p></header>
+			<nav><ul><li>Home menu item text</li><li>Products menu item text</li></ul></nav>
+			<div class="cookie-banner"><p>We use cookies to improve your experience in this site.</p></div>
+			` + content + `
+			<footer><p>Copyright notice of the site, all rights reserved for the company.</p></footer>
+		</body></html>`,
+		`<html><body>
+			<div id="sidebar"><p>Different sidebar text in this page with some other words.</p></div>
+			` + content + `
+		</body></html>`,
+	}
+
+	pageReports := []*models.PageReport{}
+	for _, b := range bodies {
+		pageReport, _, err := services.NewHTMLParser(u, 200, &headers, []byte(b), int64(len(b)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pageReports = append(pageReports, pageReport)
+	}
+
+	if pageReports[0].MainWords != 32 {
+		t.Errorf("MainWords want: 32 Got: %d", pageReports[0].MainWords)
+	}
+
+	if !strings.HasPrefix(pageReports[0].MainTextExcerpt, "Main content title This is the first paragraph") {
+		t.Errorf("MainTextExcerpt: %s", pageReports[0].MainTextExcerpt)
+	}
+
+	if pageReports[0].MainTextHash == "" || pageReports[0].MainTextHash != pageReports[1].MainTextHash {
+		t.Errorf("MainTextHash: %s != %s", pageReports[0].MainTextHash, pageReports[1].MainTextHash)
+	}
+}
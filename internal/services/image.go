@@ -0,0 +1,64 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// imageDimensions returns the intrinsic width and height of the image in body. Only the image
+// header is decoded. PNG, JPEG and GIF images are decoded with the standard library while the
+// WebP header is parsed by webpDimensions. It returns 0 for both values if the image format
+// is not supported or the header is not valid.
+func imageDimensions(body []byte) (int, int) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err == nil {
+		return config.Width, config.Height
+	}
+
+	return webpDimensions(body)
+}
+
+// webpDimensions parses the header of a WebP image and returns its width and height.
+// The RIFF container is followed by a VP8 chunk for lossy images, a VP8L chunk for lossless
+// images or a VP8X chunk with the canvas size for the extended format.
+func webpDimensions(b []byte) (int, int) {
+	if len(b) < 30 || string(b[0:4]) != "RIFF" || string(b[8:12]) != "WEBP" {
+		return 0, 0
+	}
+
+	switch string(b[12:16]) {
+	case "VP8 ":
+		// The frame tag is followed by the 0x9d 0x01 0x2a start code and the 14 bit dimensions.
+		if b[23] != 0x9d || b[24] != 0x01 || b[25] != 0x2a {
+			return 0, 0
+		}
+
+		width := int(binary.LittleEndian.Uint16(b[26:28]) & 0x3fff)
+		height := int(binary.LittleEndian.Uint16(b[28:30]) & 0x3fff)
+
+		return width, height
+	case "VP8L":
+		// The 0x2f signature is followed by the 14 bit width and height minus one.
+		if b[20] != 0x2f {
+			return 0, 0
+		}
+
+		bits := binary.LittleEndian.Uint32(b[21:25])
+		width := int(bits&0x3fff) + 1
+		height := int((bits>>14)&0x3fff) + 1
+
+		return width, height
+	case "VP8X":
+		// The canvas width and height minus one are stored as 24 bit values.
+		width := int(uint32(b[24])|uint32(b[25])<<8|uint32(b[26])<<16) + 1
+		height := int(uint32(b[27])|uint32(b[28])<<8|uint32(b[29])<<16) + 1
+
+		return width, height
+	}
+
+	return 0, 0
+}
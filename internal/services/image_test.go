@@ -0,0 +1,76 @@
+package services_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+// Test the image dimensions are decoded from the PNG, JPEG, GIF and WebP image headers.
+func TestImageDimensions(t *testing.T) {
+	u, err := url.Parse("https://example.com/image")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, 120, 80))
+
+	var pngBody, jpegBody, gifBody bytes.Buffer
+	if err := png.Encode(&pngBody, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := jpeg.Encode(&jpegBody, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := gif.Encode(&gifBody, img, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lossy WebP header with the VP8 start code followed by the width and height.
+	webpBody := make([]byte, 30)
+	copy(webpBody[0:], "RIFF")
+	copy(webpBody[8:], "WEBPVP8 ")
+	copy(webpBody[23:], []byte{0x9d, 0x01, 0x2a})
+	binary.LittleEndian.PutUint16(webpBody[26:], 120)
+	binary.LittleEndian.PutUint16(webpBody[28:], 80)
+
+	// Extended WebP header with the canvas size minus one.
+	webpxBody := make([]byte, 30)
+	copy(webpxBody[0:], "RIFF")
+	copy(webpxBody[8:], "WEBPVP8X")
+	copy(webpxBody[24:], []byte{119, 0, 0, 79, 0, 0})
+
+	table := []struct {
+		mediaType string
+		body      []byte
+	}{
+		{"image/png", pngBody.Bytes()},
+		{"image/jpeg", jpegBody.Bytes()},
+		{"image/gif", gifBody.Bytes()},
+		{"image/webp", webpBody},
+		{"image/webp", webpxBody},
+	}
+
+	for _, tc := range table {
+		headers := &http.Header{
+			"Content-Type": []string{tc.mediaType},
+		}
+
+		pageReport, _, err := services.NewHTMLParser(u, 200, headers, tc.body, int64(len(tc.body)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if pageReport.ImageWidth != 120 || pageReport.ImageHeight != 80 {
+			t.Errorf("%s dimensions want 120x80 got %dx%d", tc.mediaType, pageReport.ImageWidth, pageReport.ImageHeight)
+		}
+	}
+}
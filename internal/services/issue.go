@@ -12,12 +12,23 @@ const (
 	Warning
 )
 
+// Issue type of the pages with near duplicate content.
+const NearDuplicateIssueType = "ERROR_NEAR_DUPLICATE_CONTENT"
+
+// Category of the accessibility issue types.
+const AccessibilityCategory = "accessibility"
+
+// Category of the security issue types.
+const SecurityCategory = "security"
+
 type (
 	IssueServiceRepository interface {
-		GetNumberOfPagesForIssues(int64, string) int
-		FindPageReportIssues(int64, int, string) []models.PageReport
+		GetNumberOfPagesForIssues(int64, string, bool) int
+		FindPageReportIssues(int64, int, string, bool) []models.PageReport
 		FindIssuesByTypeAndPriority(int64, int) []models.IssueGroup
 		FindPassedIssues(cid int64) []models.IssueGroup
+		FindCustomIssueName(projectId int64, errorType string) string
+		FindNearDuplicates(pid int64, cid int64, minSimilarity int) []models.NearDuplicate
 	}
 
 	IssueService struct {
@@ -30,23 +41,43 @@ func NewIssueService(r IssueServiceRepository) *IssueService {
 }
 
 // GetIssuesCount returns an IssueCount with the number of issues by type.
+// The accessibility issues are listed in their own category, sorted by priority,
+// instead of being included in the priority groups.
 func (s *IssueService) GetIssuesCount(crawlID int64) *models.IssueCount {
-	return &models.IssueCount{
-		CriticalIssues: s.repository.FindIssuesByTypeAndPriority(crawlID, Critical),
-		AlertIssues:    s.repository.FindIssuesByTypeAndPriority(crawlID, Alert),
-		WarningIssues:  s.repository.FindIssuesByTypeAndPriority(crawlID, Warning),
-		PassedIssues:   s.repository.FindPassedIssues(crawlID),
+	issueCount := &models.IssueCount{
+		PassedIssues:        s.repository.FindPassedIssues(crawlID),
+		AccessibilityIssues: []models.IssueGroup{},
 	}
+
+	var accessibility []models.IssueGroup
+	issueCount.CriticalIssues, accessibility = splitIssueCategory(s.repository.FindIssuesByTypeAndPriority(crawlID, Critical), AccessibilityCategory)
+	issueCount.AccessibilityIssues = append(issueCount.AccessibilityIssues, accessibility...)
+
+	issueCount.AlertIssues, accessibility = splitIssueCategory(s.repository.FindIssuesByTypeAndPriority(crawlID, Alert), AccessibilityCategory)
+	issueCount.AccessibilityIssues = append(issueCount.AccessibilityIssues, accessibility...)
+
+	issueCount.WarningIssues, accessibility = splitIssueCategory(s.repository.FindIssuesByTypeAndPriority(crawlID, Warning), AccessibilityCategory)
+	issueCount.AccessibilityIssues = append(issueCount.AccessibilityIssues, accessibility...)
+
+	return issueCount
+}
+
+// GetIssueName returns the name of the issue type if it is one of the project's custom
+// issues. Built-in issue types return an empty string as they are translated instead.
+func (s *IssueService) GetIssueName(projectId int64, issueId string) string {
+	return s.repository.FindCustomIssueName(projectId, issueId)
 }
 
-// Returns a PaginatorView with the corresponding page reports.
-func (s *IssueService) GetPaginatedReportsByIssue(crawlId int64, currentPage int, issueId string) (models.PaginatorView, error) {
+// Returns a PaginatorView with the corresponding page reports. If suppressed is true it returns
+// the page reports with suppressed issues instead. The first page is returned even if it's empty,
+// as all the issues of the type may have been suppressed.
+func (s *IssueService) GetPaginatedReportsByIssue(crawlId int64, currentPage int, issueId string, suppressed bool) (models.PaginatorView, error) {
 	paginator := models.Paginator{
-		TotalPages:  s.repository.GetNumberOfPagesForIssues(crawlId, issueId),
+		TotalPages:  s.repository.GetNumberOfPagesForIssues(crawlId, issueId, suppressed),
 		CurrentPage: currentPage,
 	}
 
-	if currentPage < 1 || currentPage > paginator.TotalPages {
+	if currentPage < 1 || (currentPage > paginator.TotalPages && currentPage > 1) {
 		return models.PaginatorView{}, errors.New("page out of bounds")
 	}
 
@@ -60,8 +91,35 @@ func (s *IssueService) GetPaginatedReportsByIssue(crawlId int64, currentPage int
 
 	paginatorView := models.PaginatorView{
 		Paginator:   paginator,
-		PageReports: s.repository.FindPageReportIssues(crawlId, currentPage, issueId),
+		PageReports: s.repository.FindPageReportIssues(crawlId, currentPage, issueId, suppressed),
 	}
 
 	return paginatorView, nil
 }
+
+// GetNearDuplicates returns the near duplicates of each one of the page reports,
+// using their id as the key.
+func (s *IssueService) GetNearDuplicates(crawlId int64, pageReports []models.PageReport, minSimilarity int) map[int64][]models.NearDuplicate {
+	nearDuplicates := make(map[int64][]models.NearDuplicate)
+	for _, p := range pageReports {
+		nearDuplicates[p.Id] = s.repository.FindNearDuplicates(p.Id, crawlId, minSimilarity)
+	}
+
+	return nearDuplicates
+}
+
+// splitIssueCategory splits the issue groups into the ones that don't belong to the category
+// and the ones that do.
+func splitIssueCategory(groups []models.IssueGroup, category string) ([]models.IssueGroup, []models.IssueGroup) {
+	others := []models.IssueGroup{}
+	matching := []models.IssueGroup{}
+	for _, g := range groups {
+		if g.Category == category {
+			matching = append(matching, g)
+		} else {
+			others = append(others, g)
+		}
+	}
+
+	return others, matching
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	IssueSuppressionServiceRepository interface {
+		FindIssueSuppressions(projectId int64) []models.IssueSuppression
+		SaveIssueSuppression(*models.IssueSuppression) error
+		DeleteIssueSuppression(id, projectId int64) error
+
+		GetLastCrawl(p *models.Project) models.Crawl
+		CountIssuesByPriority(int64, int) int
+		UpdateCrawlIssueCounts(*models.Crawl)
+	}
+
+	IssueSuppressionService struct {
+		repository IssueSuppressionServiceRepository
+	}
+)
+
+var (
+	// Error returned when the suppression doesn't have either a URL or a URL pattern.
+	ErrIssueSuppressionTarget = errors.New("issue suppression needs either a url or a url pattern")
+
+	// Error returned when the suppression's comment is empty.
+	ErrIssueSuppressionComment = errors.New("issue suppression comment must not be empty")
+)
+
+func NewIssueSuppressionService(r IssueSuppressionServiceRepository) *IssueSuppressionService {
+	return &IssueSuppressionService{
+		repository: r,
+	}
+}
+
+// GetSuppressions returns the project's issue suppressions.
+func (s *IssueSuppressionService) GetSuppressions(projectId int64) []models.IssueSuppression {
+	return s.repository.FindIssueSuppressions(projectId)
+}
+
+// Suppress validates the issue suppression and stores it.
+func (s *IssueSuppressionService) Suppress(suppression *models.IssueSuppression) error {
+	suppression.URL = strings.TrimSpace(suppression.URL)
+	suppression.Pattern = strings.TrimSpace(suppression.Pattern)
+	suppression.Comment = strings.TrimSpace(suppression.Comment)
+
+	err := ValidateIssueSuppression(suppression)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.SaveIssueSuppression(suppression)
+	if err != nil {
+		return err
+	}
+
+	s.updateIssueCounts(suppression.ProjectId)
+
+	return nil
+}
+
+// DeleteSuppression removes a project's issue suppression so its issues are reported again.
+func (s *IssueSuppressionService) DeleteSuppression(id, projectId int64) error {
+	err := s.repository.DeleteIssueSuppression(id, projectId)
+	if err != nil {
+		return err
+	}
+
+	s.updateIssueCounts(projectId)
+
+	return nil
+}
+
+// updateIssueCounts recalculates the issue counts stored in the project's last crawl, so they
+// don't include the suppressed issues. Crawls that are still running are skipped, as their
+// counts are calculated when they finish.
+func (s *IssueSuppressionService) updateIssueCounts(projectId int64) {
+	crawl := s.repository.GetLastCrawl(&models.Project{Id: projectId})
+	if crawl.Id == 0 || crawl.Crawling {
+		return
+	}
+
+	crawl.CriticalIssues = s.repository.CountIssuesByPriority(crawl.Id, Critical)
+	crawl.AlertIssues = s.repository.CountIssuesByPriority(crawl.Id, Alert)
+	crawl.WarningIssues = s.repository.CountIssuesByPriority(crawl.Id, Warning)
+	crawl.TotalIssues = crawl.CriticalIssues + crawl.AlertIssues + crawl.WarningIssues
+
+	s.repository.UpdateCrawlIssueCounts(&crawl)
+}
+
+// ValidateIssueSuppression checks the suppression applies either to a URL or to a URL pattern,
+// but not both, and that it has a comment explaining why the issues are accepted.
+func ValidateIssueSuppression(suppression *models.IssueSuppression) error {
+	if (suppression.URL == "") == (suppression.Pattern == "") {
+		return ErrIssueSuppressionTarget
+	}
+
+	if suppression.Comment == "" {
+		return ErrIssueSuppressionComment
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type suppressionTestRepository struct {
+	crawl *models.Crawl
+}
+
+func (r *suppressionTestRepository) FindIssueSuppressions(projectId int64) []models.IssueSuppression {
+	return []models.IssueSuppression{}
+}
+
+func (r *suppressionTestRepository) SaveIssueSuppression(s *models.IssueSuppression) error {
+	return nil
+}
+
+func (r *suppressionTestRepository) DeleteIssueSuppression(id, projectId int64) error {
+	return nil
+}
+
+func (r *suppressionTestRepository) GetLastCrawl(p *models.Project) models.Crawl {
+	return models.Crawl{Id: 1, ProjectId: p.Id, TotalIssues: 10}
+}
+
+func (r *suppressionTestRepository) CountIssuesByPriority(cid int64, p int) int {
+	return p
+}
+
+func (r *suppressionTestRepository) UpdateCrawlIssueCounts(c *models.Crawl) {
+	r.crawl = c
+}
+
+func TestValidateIssueSuppression(t *testing.T) {
+	table := []struct {
+		suppression models.IssueSuppression
+		err         error
+	}{
+		{models.IssueSuppression{URL: "https://example.com/", Comment: "Accepted"}, nil},
+		{models.IssueSuppression{Pattern: "https://example.com/tag/*", Comment: "Accepted"}, nil},
+		{models.IssueSuppression{Comment: "Accepted"}, services.ErrIssueSuppressionTarget},
+		{models.IssueSuppression{URL: "https://example.com/", Pattern: "https://example.com/*", Comment: "Accepted"}, services.ErrIssueSuppressionTarget},
+		{models.IssueSuppression{URL: "https://example.com/"}, services.ErrIssueSuppressionComment},
+	}
+
+	for _, v := range table {
+		err := services.ValidateIssueSuppression(&v.suppression)
+		if err != v.err {
+			t.Errorf("ValidateIssueSuppression %+v want: %v Got: %v", v.suppression, v.err, err)
+		}
+	}
+}
+
+func TestSuppressUpdatesIssueCounts(t *testing.T) {
+	repository := &suppressionTestRepository{}
+	service := services.NewIssueSuppressionService(repository)
+
+	err := service.Suppress(&models.IssueSuppression{ProjectId: 1, URL: "https://example.com/", Comment: "Accepted"})
+	if err != nil {
+		t.Fatalf("Suppress: %v", err)
+	}
+
+	if repository.crawl == nil {
+		t.Fatalf("Suppress: the crawl's issue counts were not updated")
+	}
+
+	c := repository.crawl
+	if c.CriticalIssues != services.Critical || c.AlertIssues != services.Alert || c.WarningIssues != services.Warning {
+		t.Errorf("Suppress: unexpected issue counts %d %d %d", c.CriticalIssues, c.AlertIssues, c.WarningIssues)
+	}
+
+	if c.TotalIssues != services.Critical+services.Alert+services.Warning {
+		t.Errorf("Suppress: want total issues %d got %d", services.Critical+services.Alert+services.Warning, c.TotalIssues)
+	}
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	IssueThresholdsServiceRepository interface {
+		FindIssueThresholds(projectId int64) (models.IssueThresholds, error)
+		SaveIssueThresholds(*models.IssueThresholds) error
+		DeleteIssueThresholds(projectId int64) error
+	}
+
+	IssueThresholdsService struct {
+		repository IssueThresholdsServiceRepository
+	}
+)
+
+// Error returned when a threshold is not a positive number, a minimum value is not lower
+// than its maximum value or the similarity percentage is greater than 100.
+var ErrIssueThresholds = errors.New("thresholds must be positive and minimum values lower than maximum values")
+
+func NewIssueThresholdsService(r IssueThresholdsServiceRepository) *IssueThresholdsService {
+	return &IssueThresholdsService{
+		repository: r,
+	}
+}
+
+// GetThresholds returns the project's issue thresholds, or the default ones
+// if the project doesn't have its own thresholds.
+func (s *IssueThresholdsService) GetThresholds(projectId int64) models.IssueThresholds {
+	return projectThresholds(s.repository.FindIssueThresholds, projectId)
+}
+
+// SaveThresholds validates the thresholds and stores them.
+func (s *IssueThresholdsService) SaveThresholds(t *models.IssueThresholds) error {
+	err := ValidateIssueThresholds(t)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SaveIssueThresholds(t)
+}
+
+// ResetThresholds removes the project's thresholds so the default ones are used.
+func (s *IssueThresholdsService) ResetThresholds(projectId int64) error {
+	return s.repository.DeleteIssueThresholds(projectId)
+}
+
+// ValidateIssueThresholds checks all the thresholds are positive, the minimum lengths
+// are lower than the maximum lengths and the similarity is a valid percentage.
+func ValidateIssueThresholds(t *models.IssueThresholds) error {
+	values := []int64{
+		int64(t.MinWords),
+		int64(t.MaxDOMSize),
+		int64(t.MinTitleLength),
+		int64(t.MaxTitleLength),
+		int64(t.MinDescriptionLength),
+		int64(t.MaxDescriptionLength),
+		int64(t.MaxTTFB),
+		t.MaxImageSize,
+		int64(t.MaxAltTextLength),
+		int64(t.MaxLinks),
+		int64(t.MinSimilarity),
+		t.MaxPageWeight,
+		t.MaxScriptsWeight,
+		int64(t.MaxPageRequests),
+		t.MaxLegacyImageSize,
+	}
+
+	for _, v := range values {
+		if v <= 0 {
+			return ErrIssueThresholds
+		}
+	}
+
+	if t.MinTitleLength >= t.MaxTitleLength || t.MinDescriptionLength >= t.MaxDescriptionLength {
+		return ErrIssueThresholds
+	}
+
+	if t.MinSimilarity > 100 {
+		return ErrIssueThresholds
+	}
+
+	return nil
+}
+
+// projectThresholds returns the project's issue thresholds using the find function. In case
+// the project doesn't have its own thresholds the default ones are returned.
+func projectThresholds(find func(int64) (models.IssueThresholds, error), projectId int64) models.IssueThresholds {
+	t, err := find(projectId)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Printf("FindIssueThresholds: %v\n", err)
+		}
+
+		t = page.DefaultThresholds()
+		t.ProjectId = projectId
+	}
+
+	return t
+}
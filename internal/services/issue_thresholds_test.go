@@ -0,0 +1,45 @@
+package services_test
+
+import (
+	"testing"
+
+	issues "github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestValidateIssueThresholds(t *testing.T) {
+	thresholds := issues.DefaultThresholds()
+	if err := services.ValidateIssueThresholds(&thresholds); err != nil {
+		t.Errorf("ValidateIssueThresholds default thresholds: %v", err)
+	}
+
+	negative := issues.DefaultThresholds()
+	negative.MaxLinks = -1
+	if err := services.ValidateIssueThresholds(&negative); err != services.ErrIssueThresholds {
+		t.Errorf("ValidateIssueThresholds negative value want: %v Got: %v", services.ErrIssueThresholds, err)
+	}
+
+	similarity := issues.DefaultThresholds()
+	similarity.MinSimilarity = 101
+	if err := services.ValidateIssueThresholds(&similarity); err != services.ErrIssueThresholds {
+		t.Errorf("ValidateIssueThresholds similarity want: %v Got: %v", services.ErrIssueThresholds, err)
+	}
+
+	weight := issues.DefaultThresholds()
+	weight.MaxPageWeight = 0
+	if err := services.ValidateIssueThresholds(&weight); err != services.ErrIssueThresholds {
+		t.Errorf("ValidateIssueThresholds page weight want: %v Got: %v", services.ErrIssueThresholds, err)
+	}
+
+	legacy := issues.DefaultThresholds()
+	legacy.MaxLegacyImageSize = 0
+	if err := services.ValidateIssueThresholds(&legacy); err != services.ErrIssueThresholds {
+		t.Errorf("ValidateIssueThresholds legacy image size want: %v Got: %v", services.ErrIssueThresholds, err)
+	}
+
+	titles := issues.DefaultThresholds()
+	titles.MinTitleLength = titles.MaxTitleLength
+	if err := services.ValidateIssueThresholds(&titles); err != services.ErrIssueThresholds {
+		t.Errorf("ValidateIssueThresholds title lengths want: %v Got: %v", services.ErrIssueThresholds, err)
+	}
+}
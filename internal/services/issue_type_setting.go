@@ -0,0 +1,53 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	IssueTypeSettingServiceRepository interface {
+		FindIssueTypeSettings(projectId int64) []models.IssueTypeSetting
+		SaveIssueTypeSettings(projectId int64, settings []models.IssueTypeSetting) error
+	}
+
+	IssueTypeSettingService struct {
+		repository IssueTypeSettingServiceRepository
+	}
+)
+
+// Error returned when an issue type setting has a priority other than Critical, Alert or Warning.
+var ErrIssueTypePriority = errors.New("issue type priority not supported")
+
+func NewIssueTypeSettingService(r IssueTypeSettingServiceRepository) *IssueTypeSettingService {
+	return &IssueTypeSettingService{
+		repository: r,
+	}
+}
+
+// GetSettings returns the settings of all the issue types available in the project.
+func (s *IssueTypeSettingService) GetSettings(projectId int64) []models.IssueTypeSetting {
+	return s.repository.FindIssueTypeSettings(projectId)
+}
+
+// SaveSettings updates the project's issue type settings. The settings map is keyed by
+// the issue type id, and issue types not included in the map keep their current settings.
+func (s *IssueTypeSettingService) SaveSettings(projectId int64, updated map[int]models.IssueTypeSetting) error {
+	settings := s.repository.FindIssueTypeSettings(projectId)
+	for i, setting := range settings {
+		u, ok := updated[setting.IssueTypeId]
+		if !ok {
+			continue
+		}
+
+		if u.Priority != Critical && u.Priority != Alert && u.Priority != Warning {
+			return ErrIssueTypePriority
+		}
+
+		settings[i].Priority = u.Priority
+		settings[i].Disabled = u.Disabled
+	}
+
+	return s.repository.SaveIssueTypeSettings(projectId, settings)
+}
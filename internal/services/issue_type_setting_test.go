@@ -0,0 +1,56 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+// Mock repository that stores the saved issue type settings.
+type issueTypeSettingTestRepository struct {
+	saved []models.IssueTypeSetting
+}
+
+func (r *issueTypeSettingTestRepository) FindIssueTypeSettings(projectId int64) []models.IssueTypeSetting {
+	return []models.IssueTypeSetting{
+		{IssueTypeId: 1, Type: "ERROR_30x", DefaultPriority: services.Critical, Priority: services.Critical},
+		{IssueTypeId: 2, Type: "ERROR_40x", DefaultPriority: services.Critical, Priority: services.Critical},
+	}
+}
+
+func (r *issueTypeSettingTestRepository) SaveIssueTypeSettings(projectId int64, settings []models.IssueTypeSetting) error {
+	r.saved = settings
+	return nil
+}
+
+func TestSaveIssueTypeSettings(t *testing.T) {
+	repository := &issueTypeSettingTestRepository{}
+	service := services.NewIssueTypeSettingService(repository)
+
+	err := service.SaveSettings(1, map[int]models.IssueTypeSetting{
+		1: {IssueTypeId: 1, Priority: services.Warning, Disabled: true},
+	})
+	if err != nil {
+		t.Fatalf("SaveSettings: %v", err)
+	}
+
+	if len(repository.saved) != 2 {
+		t.Fatalf("SaveSettings: %d != 2", len(repository.saved))
+	}
+
+	if repository.saved[0].Priority != services.Warning || !repository.saved[0].Disabled {
+		t.Errorf("SaveSettings: issue type 1 was not updated")
+	}
+
+	if repository.saved[1].Priority != services.Critical || repository.saved[1].Disabled {
+		t.Errorf("SaveSettings: issue type 2 should keep its settings")
+	}
+
+	err = service.SaveSettings(1, map[int]models.IssueTypeSetting{
+		1: {IssueTypeId: 1, Priority: 5},
+	})
+	if err != services.ErrIssueTypePriority {
+		t.Errorf("SaveSettings want: %v Got: %v", services.ErrIssueTypePriority, err)
+	}
+}
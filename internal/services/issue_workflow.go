@@ -0,0 +1,105 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	IssueWorkflowServiceRepository interface {
+		FindIssueWorkflow(projectId int64, errorType string) (models.IssueWorkflow, error)
+		SaveIssueWorkflow(*models.IssueWorkflow) error
+		FindIssueComments(projectId int64, errorType string) []models.IssueComment
+		SaveIssueComment(*models.IssueComment) error
+		FindProjectUsers(projectId int64) []models.User
+	}
+
+	IssueWorkflowService struct {
+		repository IssueWorkflowServiceRepository
+	}
+)
+
+var (
+	// Error returned when the workflow's status is not supported.
+	ErrIssueStatus = errors.New("issue status not supported")
+
+	// Error returned when the workflow's assignee is not one of the project's users.
+	ErrIssueAssignee = errors.New("issue assignee must be one of the project's users")
+
+	// Error returned when the comment is empty.
+	ErrIssueComment = errors.New("issue comment must not be empty")
+)
+
+// IssueStatuses contains the supported issue workflow statuses in the order they are used.
+var IssueStatuses = []string{
+	models.IssueStatusOpen,
+	models.IssueStatusInProgress,
+	models.IssueStatusFixed,
+	models.IssueStatusVerified,
+}
+
+func NewIssueWorkflowService(r IssueWorkflowServiceRepository) *IssueWorkflowService {
+	return &IssueWorkflowService{
+		repository: r,
+	}
+}
+
+// GetWorkflow returns the workflow of an issue type in a project including its comments.
+func (s *IssueWorkflowService) GetWorkflow(projectId int64, errorType string) (*models.IssueWorkflow, error) {
+	w, err := s.repository.FindIssueWorkflow(projectId, errorType)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Comments = s.repository.FindIssueComments(projectId, errorType)
+
+	return &w, nil
+}
+
+// GetAssignees returns the project's users that can be assigned to its issues.
+func (s *IssueWorkflowService) GetAssignees(projectId int64) []models.User {
+	return s.repository.FindProjectUsers(projectId)
+}
+
+// SaveWorkflow validates the status and assignee of the issue type's workflow and stores it.
+func (s *IssueWorkflowService) SaveWorkflow(w *models.IssueWorkflow) error {
+	valid := false
+	for _, status := range IssueStatuses {
+		if w.Status == status {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return ErrIssueStatus
+	}
+
+	if w.AssigneeId != 0 {
+		assigned := false
+		for _, u := range s.repository.FindProjectUsers(w.ProjectId) {
+			if u.Id == w.AssigneeId {
+				assigned = true
+				break
+			}
+		}
+
+		if !assigned {
+			return ErrIssueAssignee
+		}
+	}
+
+	return s.repository.SaveIssueWorkflow(w)
+}
+
+// AddComment adds a comment to the issue type's workflow.
+func (s *IssueWorkflowService) AddComment(c *models.IssueComment) error {
+	c.Comment = strings.TrimSpace(c.Comment)
+	if c.Comment == "" {
+		return ErrIssueComment
+	}
+
+	return s.repository.SaveIssueComment(c)
+}
@@ -0,0 +1,99 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+const workflowUserId = 1
+
+type workflowTestRepository struct {
+	workflow *models.IssueWorkflow
+	comment  *models.IssueComment
+}
+
+func (r *workflowTestRepository) FindIssueWorkflow(projectId int64, errorType string) (models.IssueWorkflow, error) {
+	return models.IssueWorkflow{ProjectId: projectId, ErrorType: errorType, Status: models.IssueStatusOpen}, nil
+}
+
+func (r *workflowTestRepository) SaveIssueWorkflow(w *models.IssueWorkflow) error {
+	r.workflow = w
+	return nil
+}
+
+func (r *workflowTestRepository) FindIssueComments(projectId int64, errorType string) []models.IssueComment {
+	return []models.IssueComment{{ProjectId: projectId, ErrorType: errorType, Comment: "Comment"}}
+}
+
+func (r *workflowTestRepository) SaveIssueComment(c *models.IssueComment) error {
+	r.comment = c
+	return nil
+}
+
+func (r *workflowTestRepository) FindProjectUsers(projectId int64) []models.User {
+	return []models.User{{Id: workflowUserId}}
+}
+
+func TestGetWorkflow(t *testing.T) {
+	service := services.NewIssueWorkflowService(&workflowTestRepository{})
+
+	w, err := service.GetWorkflow(1, errorType)
+	if err != nil {
+		t.Fatalf("GetWorkflow: %v", err)
+	}
+
+	if w.Status != models.IssueStatusOpen {
+		t.Errorf("GetWorkflow status want: %s Got: %s", models.IssueStatusOpen, w.Status)
+	}
+
+	if len(w.Comments) != 1 {
+		t.Errorf("GetWorkflow comments want: 1 Got: %d", len(w.Comments))
+	}
+}
+
+func TestSaveWorkflow(t *testing.T) {
+	table := []struct {
+		workflow models.IssueWorkflow
+		err      error
+	}{
+		{models.IssueWorkflow{Status: models.IssueStatusInProgress, AssigneeId: workflowUserId}, nil},
+		{models.IssueWorkflow{Status: models.IssueStatusFixed}, nil},
+		{models.IssueWorkflow{Status: "closed"}, services.ErrIssueStatus},
+		{models.IssueWorkflow{Status: models.IssueStatusOpen, AssigneeId: workflowUserId + 1}, services.ErrIssueAssignee},
+	}
+
+	for _, v := range table {
+		repository := &workflowTestRepository{}
+		service := services.NewIssueWorkflowService(repository)
+
+		err := service.SaveWorkflow(&v.workflow)
+		if err != v.err {
+			t.Errorf("SaveWorkflow %+v want: %v Got: %v", v.workflow, v.err, err)
+		}
+
+		if err == nil && repository.workflow == nil {
+			t.Errorf("SaveWorkflow %+v workflow not saved", v.workflow)
+		}
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	repository := &workflowTestRepository{}
+	service := services.NewIssueWorkflowService(repository)
+
+	err := service.AddComment(&models.IssueComment{Comment: "  "})
+	if err != services.ErrIssueComment {
+		t.Errorf("AddComment empty comment want: %v Got: %v", services.ErrIssueComment, err)
+	}
+
+	err = service.AddComment(&models.IssueComment{Comment: " Fixed in the template "})
+	if err != nil {
+		t.Errorf("AddComment: %v", err)
+	}
+
+	if repository.comment == nil || repository.comment.Comment != "Fixed in the template" {
+		t.Errorf("AddComment comment not saved: %+v", repository.comment)
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/urlutils"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+type (
+	LinkPositionServiceRepository interface {
+		FindLinkPositionRules(projectId int64) []models.LinkPositionRule
+		SaveLinkPositionRule(*models.LinkPositionRule) error
+		DeleteLinkPositionRule(id, projectId int64) error
+	}
+
+	LinkPositionService struct {
+		repository LinkPositionServiceRepository
+	}
+)
+
+var (
+	// Error returned when the link position rule's XPath can't be compiled.
+	ErrLinkPositionXPath = errors.New("link position rule xpath is not valid")
+
+	// Error returned when the link position rule's position is not supported.
+	ErrLinkPosition = errors.New("link position not supported")
+)
+
+// Elements and ARIA roles that define the position of the links they contain.
+var (
+	linkPositionTags = map[string]string{
+		"nav":    models.LinkPositionNavigation,
+		"header": models.LinkPositionHeader,
+		"footer": models.LinkPositionFooter,
+		"aside":  models.LinkPositionAside,
+	}
+
+	linkPositionRoles = map[string]string{
+		"navigation":    models.LinkPositionNavigation,
+		"banner":        models.LinkPositionHeader,
+		"contentinfo":   models.LinkPositionFooter,
+		"complementary": models.LinkPositionAside,
+	}
+)
+
+func NewLinkPositionService(r LinkPositionServiceRepository) *LinkPositionService {
+	return &LinkPositionService{
+		repository: r,
+	}
+}
+
+// GetRules returns the project's link position rules.
+func (s *LinkPositionService) GetRules(projectId int64) []models.LinkPositionRule {
+	return s.repository.FindLinkPositionRules(projectId)
+}
+
+// SaveRule validates the link position rule and stores it.
+func (s *LinkPositionService) SaveRule(rule *models.LinkPositionRule) error {
+	rule.XPath = strings.TrimSpace(rule.XPath)
+
+	err := ValidateLinkPositionRule(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SaveLinkPositionRule(rule)
+}
+
+// DeleteRule removes a project's link position rule.
+func (s *LinkPositionService) DeleteRule(id, projectId int64) error {
+	return s.repository.DeleteLinkPositionRule(id, projectId)
+}
+
+// ValidateLinkPositionRule checks the rule has a valid XPath expression and a supported position.
+func ValidateLinkPositionRule(rule *models.LinkPositionRule) error {
+	if !slices.Contains(models.LinkPositions, rule.Position) {
+		return ErrLinkPosition
+	}
+
+	_, err := htmlquery.QueryAll(&html.Node{Type: html.DocumentNode}, rule.XPath)
+	if err != nil || rule.XPath == "" {
+		return ErrLinkPositionXPath
+	}
+
+	return nil
+}
+
+// ApplyLinkPositionRules sets the position of the page's links contained in the elements
+// matched by the rules. If an element is matched by more than one rule the first one is used.
+// The links are matched with the document's anchor elements in the same order the parser
+// extracts them.
+func ApplyLinkPositionRules(rules []models.LinkPositionRule, pageReport *models.PageReport, doc *html.Node) {
+	overrides := make(map[*html.Node]string)
+	for _, rule := range rules {
+		nodes, err := htmlquery.QueryAll(doc, rule.XPath)
+		if err != nil {
+			continue
+		}
+
+		for _, n := range nodes {
+			if _, ok := overrides[n]; !ok {
+				overrides[n] = rule.Position
+			}
+		}
+	}
+
+	if len(overrides) == 0 {
+		return
+	}
+
+	internal, external := 0, 0
+	for _, n := range htmlquery.Find(doc, "//a[@href]") {
+		u, err := urlutils.AbsoluteURL(htmlquery.SelectAttr(n, "href"), doc, pageReport.ParsedURL)
+		if err != nil {
+			continue
+		}
+
+		links, i := pageReport.Links, &internal
+		if u.Host != pageReport.ParsedURL.Host {
+			links, i = pageReport.ExternalLinks, &external
+		}
+
+		if *i >= len(links) || links[*i].URL != u.String() {
+			log.Printf("ApplyLinkPositionRules: links don't match in %s", pageReport.URL)
+			return
+		}
+
+		links[*i].Position = linkPosition(n, overrides)
+		*i++
+	}
+}
+
+// linkPosition returns the position of the link node depending on its closest ancestor that
+// is in the overrides map, is a nav, header, footer or aside element, or has an equivalent ARIA
+// role. If there's none, the link is in the page's content.
+func linkPosition(n *html.Node, overrides map[*html.Node]string) string {
+	for a := n; a != nil; a = a.Parent {
+		if position, ok := overrides[a]; ok {
+			return position
+		}
+
+		if a.Type != html.ElementNode {
+			continue
+		}
+
+		if position, ok := linkPositionRoles[strings.TrimSpace(htmlquery.SelectAttr(a, "role"))]; ok {
+			return position
+		}
+
+		position, ok := linkPositionTags[a.Data]
+		if !ok {
+			continue
+		}
+
+		// Headers and footers of articles and sections are part of the content.
+		if (a.Data == "header" || a.Data == "footer") && inSectioningContent(a) {
+			return models.LinkPositionContent
+		}
+
+		return position
+	}
+
+	return models.LinkPositionContent
+}
+
+// inSectioningContent returns true if the node is inside a main, article or section element.
+func inSectioningContent(n *html.Node) bool {
+	for a := n.Parent; a != nil; a = a.Parent {
+		if a.Type == html.ElementNode && (a.Data == "main" || a.Data == "article" || a.Data == "section") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+const linkPositionBody = `
+<html>
+	<body>
+		<header>
+			<a href="/logo">Logo</a>
+			<nav><a href="/products">Products</a></nav>
+		</header>
+		<div role="navigation"><a href="/menu">Menu</a></div>
+		<main>
+			<article>
+				<header><a href="/category">Category</a></header>
+				<p>Text with a <a href="/contextual">contextual link</a>.</p>
+				<div class="related"><a href="/related">Related</a></div>
+			</article>
+		</main>
+		<aside><a href="/sidebar">Sidebar</a></aside>
+		<footer><a href="https://external.com/">External</a></footer>
+	</body>
+</html>`
+
+func linkPositionPageReport(t *testing.T) *models.PageReport {
+	u, err := url.Parse("https://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	headers := http.Header{"Content-Type": []string{"text/html"}}
+	pageReport, _, err := services.NewHTMLParser(u, 200, &headers, []byte(linkPositionBody), int64(len(linkPositionBody)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pageReport
+}
+
+func TestLinkPosition(t *testing.T) {
+	pageReport := linkPositionPageReport(t)
+
+	want := map[string]string{
+		"https://example.com/logo":       models.LinkPositionHeader,
+		"https://example.com/products":   models.LinkPositionNavigation,
+		"https://example.com/menu":       models.LinkPositionNavigation,
+		"https://example.com/category":   models.LinkPositionContent,
+		"https://example.com/contextual": models.LinkPositionContent,
+		"https://example.com/related":    models.LinkPositionContent,
+		"https://example.com/sidebar":    models.LinkPositionAside,
+		"https://external.com/":          models.LinkPositionFooter,
+	}
+
+	links := append(pageReport.Links, pageReport.ExternalLinks...)
+	if len(links) != len(want) {
+		t.Fatalf("links want: %d Got: %d", len(want), len(links))
+	}
+
+	for _, l := range links {
+		if l.Position != want[l.URL] {
+			t.Errorf("link position %s want: %s Got: %s", l.URL, want[l.URL], l.Position)
+		}
+	}
+}
+
+func TestApplyLinkPositionRules(t *testing.T) {
+	u, _ := url.Parse("https://example.com/")
+	headers := http.Header{"Content-Type": []string{"text/html"}}
+	pageReport, doc, err := services.NewHTMLParser(u, 200, &headers, []byte(linkPositionBody), int64(len(linkPositionBody)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := []models.LinkPositionRule{
+		{XPath: "//div[@class='related']", Position: models.LinkPositionAside},
+		{XPath: "//footer", Position: models.LinkPositionContent},
+		{XPath: "//div[@class='related']", Position: models.LinkPositionFooter},
+	}
+
+	services.ApplyLinkPositionRules(rules, pageReport, doc)
+
+	for _, l := range pageReport.Links {
+		if l.URL == "https://example.com/related" && l.Position != models.LinkPositionAside {
+			t.Errorf("ApplyLinkPositionRules related want: %s Got: %s", models.LinkPositionAside, l.Position)
+		}
+
+		if l.URL == "https://example.com/products" && l.Position != models.LinkPositionNavigation {
+			t.Errorf("ApplyLinkPositionRules products want: %s Got: %s", models.LinkPositionNavigation, l.Position)
+		}
+	}
+
+	if pageReport.ExternalLinks[0].Position != models.LinkPositionContent {
+		t.Errorf("ApplyLinkPositionRules external want: %s Got: %s", models.LinkPositionContent, pageReport.ExternalLinks[0].Position)
+	}
+}
+
+func TestValidateLinkPositionRule(t *testing.T) {
+	table := []struct {
+		rule models.LinkPositionRule
+		err  error
+	}{
+		{models.LinkPositionRule{XPath: "//div[@id='menu']", Position: models.LinkPositionNavigation}, nil},
+		{models.LinkPositionRule{XPath: "//div[@id='menu'", Position: models.LinkPositionNavigation}, services.ErrLinkPositionXPath},
+		{models.LinkPositionRule{XPath: "", Position: models.LinkPositionNavigation}, services.ErrLinkPositionXPath},
+		{models.LinkPositionRule{XPath: "//div", Position: "sidebar"}, services.ErrLinkPosition},
+	}
+
+	for _, v := range table {
+		err := services.ValidateLinkPositionRule(&v.rule)
+		if err != v.err {
+			t.Errorf("ValidateLinkPositionRule %+v want: %v Got: %v", v.rule, v.err, err)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"errors"
+	"log"
+	"math"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+const (
+	linkScoreDamping    = 0.85 // Probability of following a link in the PageRank model.
+	linkScoreIterations = 50   // Max number of iterations to compute the link scores.
+	linkScoreTolerance  = 1e-9 // The iterations stop once the scores change less than this.
+
+	// Indexable pages in the sitemap with a link score lower than this are poorly linked.
+	LinkScoreThreshold = 10
+)
+
+type (
+	LinkScoreServiceRepository interface {
+		FindLinkGraph(crawlId int64) *models.LinkGraph
+		SaveLinkScores(crawlId int64, scores map[int64]float64) error
+		GetNumberOfPagesForLowLinkScore(crawlId int64, threshold float64) int
+		FindLowLinkScorePageReports(crawlId int64, threshold float64, p int) []models.PageReport
+	}
+
+	LinkScoreService struct {
+		repository LinkScoreServiceRepository
+	}
+)
+
+func NewLinkScoreService(r LinkScoreServiceRepository) *LinkScoreService {
+	return &LinkScoreService{
+		repository: r,
+	}
+}
+
+// GetLinkGraph returns the crawl's internal link graph. It must be called once all the crawl's
+// pages and links have been stored.
+func (s *LinkScoreService) GetLinkGraph(crawl *models.Crawl) *models.LinkGraph {
+	return s.repository.FindLinkGraph(crawl.Id)
+}
+
+// UpdateLinkScores computes the link scores of the crawl's pages using its internal link graph
+// and stores them.
+func (s *LinkScoreService) UpdateLinkScores(crawl *models.Crawl, graph *models.LinkGraph) {
+	scores := LinkScores(graph)
+
+	err := s.repository.SaveLinkScores(crawl.Id, scores)
+	if err != nil {
+		log.Printf("UpdateLinkScores: %v\n", err)
+	}
+}
+
+// GetPaginatedLowLinkScore returns a PaginatorView with the indexable pages included in the
+// sitemap that have a link score lower than LinkScoreThreshold.
+func (s *LinkScoreService) GetPaginatedLowLinkScore(crawlId int64, currentPage int) (models.PaginatorView, error) {
+	paginator := models.Paginator{
+		TotalPages:  s.repository.GetNumberOfPagesForLowLinkScore(crawlId, LinkScoreThreshold),
+		CurrentPage: currentPage,
+	}
+
+	if currentPage < 1 || (currentPage > paginator.TotalPages && currentPage > 1) {
+		return models.PaginatorView{}, errors.New("page out of bounds")
+	}
+
+	if currentPage < paginator.TotalPages {
+		paginator.NextPage = currentPage + 1
+	}
+
+	if currentPage > 1 {
+		paginator.PreviousPage = currentPage - 1
+	}
+
+	return models.PaginatorView{
+		Paginator:   paginator,
+		PageReports: s.repository.FindLowLinkScorePageReports(crawlId, LinkScoreThreshold, currentPage),
+	}, nil
+}
+
+// LinkScores computes a PageRank score for each page in the link graph and returns the scores
+// normalized from 0 to 100 using a logarithmic scale.
+// Nofollow links don't transfer any score, but they count as outgoing links so the score they
+// would pass is lost, as well as the score of links to URLs that were not crawled. Redirects and
+// canonicals transfer all the page's score to their target. Pages without outgoing links
+// distribute their score among all the pages.
+func LinkScores(g *models.LinkGraph) map[int64]float64 {
+	scores := make(map[int64]float64)
+	n := len(g.Pages)
+	if n == 0 {
+		return scores
+	}
+
+	index := make(map[int64]int, n)
+	for i, id := range g.Pages {
+		index[id] = i
+	}
+
+	outlinks := make([][]int, n)
+	outCount := make([]int, n)
+	for _, l := range g.Links {
+		from, ok := index[l.From]
+		if !ok || l.From == l.To {
+			continue
+		}
+
+		outCount[from]++
+
+		to, ok := index[l.To]
+		if !ok || l.NoFollow {
+			continue
+		}
+
+		outlinks[from] = append(outlinks[from], to)
+	}
+
+	// Redirects take precedence over canonicals, as redirecting pages don't have content.
+	transfers := []map[int64]int64{g.Canonicals, g.Redirects}
+	for _, t := range transfers {
+		for fromId, toId := range t {
+			from, okFrom := index[fromId]
+			to, okTo := index[toId]
+			if !okFrom || !okTo || from == to {
+				continue
+			}
+
+			outlinks[from] = []int{to}
+			outCount[from] = 1
+		}
+	}
+
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+
+	for iteration := 0; iteration < linkScoreIterations; iteration++ {
+		dangling := 0.0
+		for i := range rank {
+			if outCount[i] == 0 {
+				dangling += rank[i]
+			}
+		}
+
+		base := (1-linkScoreDamping)/float64(n) + linkScoreDamping*dangling/float64(n)
+		next := make([]float64, n)
+		for i := range next {
+			next[i] = base
+		}
+
+		for i, targets := range outlinks {
+			if outCount[i] == 0 {
+				continue
+			}
+
+			share := linkScoreDamping * rank[i] / float64(outCount[i])
+			for _, t := range targets {
+				next[t] += share
+			}
+		}
+
+		delta := 0.0
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+
+		rank = next
+		if delta < linkScoreTolerance {
+			break
+		}
+	}
+
+	minRank, maxRank := rank[0], rank[0]
+	for _, r := range rank {
+		minRank = math.Min(minRank, r)
+		maxRank = math.Max(maxRank, r)
+	}
+
+	for i, id := range g.Pages {
+		if maxRank == minRank {
+			scores[id] = 100
+			continue
+		}
+
+		scores[id] = 100 * (math.Log(rank[i]) - math.Log(minRank)) / (math.Log(maxRank) - math.Log(minRank))
+	}
+
+	return scores
+}
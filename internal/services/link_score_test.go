@@ -0,0 +1,64 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+// Test the link scores of a small site where the home page links to all the pages.
+// Page 4 redirects to page 2, page 5 is canonicalized to page 3 and page 6 is only
+// linked with a nofollow link.
+func TestLinkScores(t *testing.T) {
+	graph := &models.LinkGraph{
+		Pages: []int64{1, 2, 3, 4, 5, 6},
+		Links: []models.LinkGraphEdge{
+			{From: 1, To: 2},
+			{From: 1, To: 3},
+			{From: 1, To: 4},
+			{From: 1, To: 5},
+			{From: 1, To: 6, NoFollow: true},
+			{From: 2, To: 1},
+			{From: 3, To: 1},
+			{From: 3, To: 0},
+			{From: 5, To: 1},
+		},
+		Redirects:  map[int64]int64{4: 2},
+		Canonicals: map[int64]int64{5: 3},
+	}
+
+	scores := services.LinkScores(graph)
+	if len(scores) != len(graph.Pages) {
+		t.Fatalf("LinkScores want: %d Got: %d", len(graph.Pages), len(scores))
+	}
+
+	if scores[1] != 100 {
+		t.Errorf("LinkScores home page want: 100 Got: %f", scores[1])
+	}
+
+	if scores[6] != 0 {
+		t.Errorf("LinkScores nofollow page want: 0 Got: %f", scores[6])
+	}
+
+	if scores[2] <= scores[4] {
+		t.Errorf("LinkScores redirect target %f should be higher than the redirect %f", scores[2], scores[4])
+	}
+
+	if scores[3] <= scores[5] {
+		t.Errorf("LinkScores canonical %f should be higher than the canonicalized page %f", scores[3], scores[5])
+	}
+
+	for id, s := range scores {
+		if s < 0 || s > 100 {
+			t.Errorf("LinkScores page %d score out of range: %f", id, s)
+		}
+	}
+}
+
+func TestLinkScoresEmptyGraph(t *testing.T) {
+	scores := services.LinkScores(&models.LinkGraph{})
+	if len(scores) != 0 {
+		t.Errorf("LinkScores empty graph want: 0 Got: %d", len(scores))
+	}
+}
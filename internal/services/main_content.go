@@ -0,0 +1,192 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+// Max number of characters in the main content excerpt.
+const excerptLength = 300
+
+// Punctuation and symbols removed from the text to get its words.
+var nonWordRegex = regexp.MustCompile(`[\p{P}\p{S}]+`)
+
+// Elements that never contain the page's main content.
+var boilerplateElements = map[string]bool{
+	"nav":      true,
+	"header":   true,
+	"footer":   true,
+	"aside":    true,
+	"form":     true,
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"iframe":   true,
+	"select":   true,
+	"button":   true,
+}
+
+// Elements whose text is scored to find the main content.
+var paragraphElements = map[string]bool{
+	"p":          true,
+	"pre":        true,
+	"td":         true,
+	"blockquote": true,
+	"li":         true,
+	"dd":         true,
+}
+
+// Class and id values of the elements that usually contain boilerplate, such as menus,
+// cookie banners or share buttons.
+var boilerplateRegex = regexp.MustCompile(`(?i)(^|[-_\s])(nav|navbar|menu|header|footer|sidebar|cookies?|consent|banner|breadcrumbs?|comments?|share|social|related|popup|modal|newsletter|widget)($|[-_\s])`)
+
+// mainContentNode returns the node containing the page's main content. It uses the main
+// element if there's one, or the only article element in the page. Otherwise the nodes are
+// scored in a similar way to readability: the text of each paragraph adds to the score of its
+// parent and half of it to its grandparent, and the scores are lowered by the link density.
+// If no node contains enough text the body is returned.
+func mainContentNode(body *html.Node) *html.Node {
+	if main := htmlquery.FindOne(body, "//main|//*[@role='main']"); main != nil {
+		return main
+	}
+
+	if articles := htmlquery.Find(body, "//article"); len(articles) == 1 {
+		return articles[0]
+	}
+
+	scores := make(map[*html.Node]float64)
+	candidates := []*html.Node{}
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if isBoilerplate(n) {
+			return
+		}
+
+		if n.Type == html.ElementNode && paragraphElements[n.Data] {
+			text := strings.TrimSpace(contentText(n))
+			length := float64(utf8.RuneCountInString(text))
+			if length >= 25 {
+				score := 1 + float64(strings.Count(text, ",")) + min(length/100, 3)
+				for i, p := 0, n.Parent; i < 2 && p != nil && p.Type == html.ElementNode; i, p = i+1, p.Parent {
+					if _, ok := scores[p]; !ok {
+						candidates = append(candidates, p)
+					}
+					scores[p] += score / float64(i+1)
+				}
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+
+	walk(body)
+
+	var best *html.Node
+	var bestScore float64
+	for _, c := range candidates {
+		score := scores[c] * (1 - linkDensity(c))
+		if score > bestScore {
+			best = c
+			bestScore = score
+		}
+	}
+
+	if best == nil {
+		return body
+	}
+
+	return best
+}
+
+// contentText returns the text of the node excluding the boilerplate elements.
+func contentText(n *html.Node) string {
+	var buf strings.Builder
+
+	var output func(*html.Node)
+	output = func(n *html.Node) {
+		if isBoilerplate(n) {
+			return
+		}
+
+		switch n.Type {
+		case html.TextNode:
+			buf.WriteString(n.Data)
+			buf.WriteString(" ")
+			return
+		case html.CommentNode:
+			return
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			output(c)
+		}
+	}
+
+	output(n)
+
+	return buf.String()
+}
+
+// textWords returns the lowercased words in a text, removing the punctuation and symbols.
+func textWords(text string) []string {
+	return strings.Fields(nonWordRegex.ReplaceAllString(strings.ToLower(text), " "))
+}
+
+// textExcerpt returns the beginning of the text with its white space collapsed.
+func textExcerpt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= excerptLength {
+		return text
+	}
+
+	return string([]rune(text)[:excerptLength]) + "…"
+}
+
+// isBoilerplate returns true if the node is an element that doesn't contain the main content,
+// either because of its type or because of its class, id or role attributes. The attributes
+// of the elements that wrap the whole content, such as the body, are not checked.
+func isBoilerplate(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+
+	switch n.Data {
+	case "html", "body", "main", "article":
+		return false
+	}
+
+	if boilerplateElements[n.Data] {
+		return true
+	}
+
+	switch htmlquery.SelectAttr(n, "role") {
+	case "navigation", "banner", "contentinfo", "complementary", "dialog":
+		return true
+	}
+
+	return boilerplateRegex.MatchString(htmlquery.SelectAttr(n, "class")) ||
+		boilerplateRegex.MatchString(htmlquery.SelectAttr(n, "id"))
+}
+
+// linkDensity returns the proportion of the node's text that is inside links.
+func linkDensity(n *html.Node) float64 {
+	length := utf8.RuneCountInString(strings.TrimSpace(contentText(n)))
+	if length == 0 {
+		return 0
+	}
+
+	linkLength := 0
+	for _, a := range htmlquery.Find(n, "//a") {
+		linkLength += utf8.RuneCountInString(strings.TrimSpace(contentText(a)))
+	}
+
+	return min(float64(linkLength)/float64(length), 1)
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	PageWeightServiceRepository interface {
+		FindPageWeightData(crawlId int64) *models.PageWeightData
+		SavePageWeights(crawlId int64, weights []models.PageWeight) error
+		GetNumberOfPagesForPageWeights(crawlId int64) int
+		FindHeaviestPages(crawlId int64, p int) []models.PageWeight
+	}
+
+	PageWeightService struct {
+		repository PageWeightServiceRepository
+	}
+)
+
+func NewPageWeightService(r PageWeightServiceRepository) *PageWeightService {
+	return &PageWeightService{
+		repository: r,
+	}
+}
+
+// UpdatePageWeights computes the weight of the crawl's HTML pages and stores it. It must be
+// called once all the crawl's pages and resources have been stored.
+func (s *PageWeightService) UpdatePageWeights(crawl *models.Crawl) {
+	weights := PageWeights(s.repository.FindPageWeightData(crawl.Id))
+
+	err := s.repository.SavePageWeights(crawl.Id, weights)
+	if err != nil {
+		log.Printf("UpdatePageWeights: %v\n", err)
+	}
+}
+
+// GetPaginatedHeaviestPages returns a PageWeightView with the crawl's HTML pages sorted by
+// their total weight. The view's ProjectView is left empty.
+func (s *PageWeightService) GetPaginatedHeaviestPages(crawlId int64, currentPage int) (models.PageWeightView, error) {
+	paginator := models.Paginator{
+		TotalPages:  s.repository.GetNumberOfPagesForPageWeights(crawlId),
+		CurrentPage: currentPage,
+	}
+
+	if currentPage < 1 || (currentPage > paginator.TotalPages && currentPage > 1) {
+		return models.PageWeightView{}, errors.New("page out of bounds")
+	}
+
+	if currentPage < paginator.TotalPages {
+		paginator.NextPage = currentPage + 1
+	}
+
+	if currentPage > 1 {
+		paginator.PreviousPage = currentPage - 1
+	}
+
+	return models.PageWeightView{
+		Paginator:   paginator,
+		PageWeights: s.repository.FindHeaviestPages(crawlId, currentPage),
+	}, nil
+}
+
+// PageWeights returns the weight of each one of the HTML pages in the data. The weight of a page
+// is the size of the document plus the size of its scripts, styles, images and fonts, including
+// the fonts referenced in its stylesheets. Each resource is counted once per page, and only the
+// crawled resources add to the weight as the size of the rest of them is unknown.
+func PageWeights(d *models.PageWeightData) []models.PageWeight {
+	weights := []models.PageWeight{}
+
+	for _, id := range d.Pages {
+		fonts := append([]string{}, d.Fonts[id]...)
+		for _, s := range d.Styles[id] {
+			if cssId, ok := d.URLs[s]; ok {
+				fonts = append(fonts, d.Fonts[cssId]...)
+			}
+		}
+
+		w := models.PageWeight{
+			PageReportId: id,
+			Document:     d.Sizes[id],
+		}
+
+		w.Scripts, w.ScriptsCount = resourcesWeight(d, d.Scripts[id])
+		w.Styles, w.StylesCount = resourcesWeight(d, d.Styles[id])
+		w.Images, w.ImagesCount = resourcesWeight(d, d.Images[id])
+		w.Fonts, w.FontsCount = resourcesWeight(d, fonts)
+		w.Total = w.Document + w.Scripts + w.Styles + w.Images + w.Fonts
+
+		weights = append(weights, w)
+	}
+
+	return weights
+}
+
+// resourcesWeight returns the size of the crawled resources in urls and the number of
+// different resources.
+func resourcesWeight(d *models.PageWeightData, urls []string) (int64, int) {
+	var size int64
+	seen := make(map[string]bool)
+	for _, u := range urls {
+		if seen[u] {
+			continue
+		}
+
+		seen[u] = true
+		if id, ok := d.URLs[u]; ok {
+			size += d.Sizes[id]
+		}
+	}
+
+	return size, len(seen)
+}
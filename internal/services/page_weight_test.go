@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestPageWeights(t *testing.T) {
+	// Page 1 loads a script twice, a stylesheet with a font, an image that was not crawled
+	// and a preloaded font. Page 2 doesn't load any resources.
+	d := &models.PageWeightData{
+		Pages: []int64{1, 2},
+		URLs: map[string]int64{
+			"https://example.com/":           1,
+			"https://example.com/about":      2,
+			"https://example.com/app.js":     3,
+			"https://example.com/style.css":  4,
+			"https://example.com/font.woff2": 5,
+			"https://example.com/icon.woff2": 6,
+		},
+		Sizes: map[int64]int64{1: 1000, 2: 500, 3: 2000, 4: 300, 5: 4000, 6: 1500},
+		Scripts: map[int64][]string{
+			1: {"https://example.com/app.js", "https://example.com/app.js"},
+		},
+		Styles: map[int64][]string{
+			1: {"https://example.com/style.css"},
+		},
+		Images: map[int64][]string{
+			1: {"https://example.com/logo.png"},
+		},
+		Fonts: map[int64][]string{
+			1: {"https://example.com/icon.woff2"},
+			4: {"https://example.com/font.woff2"},
+		},
+	}
+
+	weights := services.PageWeights(d)
+
+	want := []models.PageWeight{
+		{
+			PageReportId: 1,
+			Document:     1000,
+			Scripts:      2000,
+			Styles:       300,
+			Images:       0,
+			Fonts:        5500,
+			Total:        8800,
+			ScriptsCount: 1,
+			StylesCount:  1,
+			ImagesCount:  1,
+			FontsCount:   2,
+		},
+		{
+			PageReportId: 2,
+			Document:     500,
+			Total:        500,
+		},
+	}
+
+	if len(weights) != len(want) {
+		t.Fatalf("PageWeights want %d weights Got: %d", len(want), len(weights))
+	}
+
+	for i, w := range want {
+		if weights[i] != w {
+			t.Errorf("PageWeights page %d want: %+v Got: %+v", w.PageReportId, w, weights[i])
+		}
+	}
+
+	if weights[0].Requests() != 5 {
+		t.Errorf("PageWeights requests want: 5 Got: %d", weights[0].Requests())
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -288,9 +290,12 @@ func (p *Parser) htmlImages() []models.Image {
 		}
 
 		alt := htmlquery.SelectAttr(n, "alt")
+		width, height := imageDimension(n, "width"), imageDimension(n, "height")
 		i := models.Image{
-			URL: url.String(),
-			Alt: alt,
+			URL:    url.String(),
+			Alt:    alt,
+			Width:  width,
+			Height: height,
 		}
 		images = append(images, i)
 
@@ -302,8 +307,11 @@ func (p *Parser) htmlImages() []models.Image {
 			}
 
 			i := models.Image{
-				URL: url.String(),
-				Alt: alt,
+				URL:    url.String(),
+				Alt:    alt,
+				Width:  width,
+				Height: height,
+				Srcset: true,
 			}
 			images = append(images, i)
 		}
@@ -364,8 +372,11 @@ func (p *Parser) htmlPictures() []models.Image {
 				}
 
 				i := models.Image{
-					URL: url.String(),
-					Alt: alt,
+					URL:    url.String(),
+					Alt:    alt,
+					Width:  imageDimension(s, "width"),
+					Height: imageDimension(s, "height"),
+					Srcset: true,
 				}
 				pictures = append(pictures, i)
 			}
@@ -487,6 +498,295 @@ func (p *Parser) htmlStyles() []string {
 	return styles
 }
 
+// Extract the preloaded font links to crawl the url
+// ex. <link rel="preload" href="/fonts/font.woff2" as="font">
+func (p *Parser) htmlFonts() []string {
+	fonts := []string{}
+	f := htmlquery.Find(p.doc, "//link[@rel=\"preload\"][@as=\"font\"]/@href")
+	for _, n := range f {
+		f := htmlquery.SelectAttr(n, "href")
+
+		url, err := urlutils.AbsoluteURL(f, p.doc, p.ParsedURL)
+		if err != nil {
+			continue
+		}
+
+		fonts = append(fonts, url.String())
+	}
+
+	return fonts
+}
+
+// Extract the resources loaded with the http scheme from an https page. Scripts, stylesheets,
+// iframes and form actions are active mixed content, while images, srcset entries, audios,
+// videos and video posters are passive mixed content.
+// ex. <script src="http://example.com/app.js"></script>
+func (p *Parser) htmlMixedContent() []models.MixedContent {
+	mixedContent := []models.MixedContent{}
+	if p.ParsedURL.Scheme != "https" {
+		return mixedContent
+	}
+
+	seen := make(map[models.MixedContent]bool)
+	add := func(t, element, s string) {
+		if strings.TrimSpace(s) == "" {
+			return
+		}
+
+		u, err := urlutils.AbsoluteURL(s, p.doc, p.ParsedURL)
+		if err != nil || u.Scheme != "http" {
+			return
+		}
+
+		m := models.MixedContent{URL: u.String(), Type: t, Element: element}
+		if seen[m] {
+			return
+		}
+
+		seen[m] = true
+		mixedContent = append(mixedContent, m)
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//script[@src]") {
+		add(models.MixedContentActive, "script", htmlquery.SelectAttr(n, "src"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//link[@href]") {
+		rel := strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel")))
+		if slices.Contains(rel, "stylesheet") {
+			add(models.MixedContentActive, "stylesheet", htmlquery.SelectAttr(n, "href"))
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//iframe[@src]") {
+		add(models.MixedContentActive, "iframe", htmlquery.SelectAttr(n, "src"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//form[@action]") {
+		add(models.MixedContentActive, "form", htmlquery.SelectAttr(n, "action"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//img[@src]") {
+		add(models.MixedContentPassive, "img", htmlquery.SelectAttr(n, "src"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//img[@srcset] | //source[@srcset]") {
+		for _, src := range p.parseSrcSet(htmlquery.SelectAttr(n, "srcset")) {
+			add(models.MixedContentPassive, "srcset", src)
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//audio[@src] | //video[@src] | //audio/source[@src] | //video/source[@src]") {
+		element := n.Data
+		if element == "source" {
+			element = n.Parent.Data
+		}
+
+		add(models.MixedContentPassive, element, htmlquery.SelectAttr(n, "src"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//video[@poster]") {
+		add(models.MixedContentPassive, "poster", htmlquery.SelectAttr(n, "poster"))
+	}
+
+	return mixedContent
+}
+
+// Extract the elements that slow down the page loading. The scripts in the head without the
+// async or defer attributes block the render, as well as the head stylesheets when there are more
+// than models.MaxHeadStylesheets of them. Preloaded resources should be referenced in the page,
+// except for fonts and fetch requests which are loaded from stylesheets and scripts. The first
+// large image is the largest contentful paint candidate and it shouldn't be lazy loaded, while the
+// images after the first models.LazyLoadMinImages are considered below the fold and should be.
+func (p *Parser) htmlLoadingFindings() []models.LoadingFinding {
+	findings := []models.LoadingFinding{}
+
+	seen := make(map[models.LoadingFinding]bool)
+	add := func(t, element, s string) {
+		u, err := urlutils.AbsoluteURL(strings.TrimSpace(s), p.doc, p.ParsedURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return
+		}
+
+		f := models.LoadingFinding{URL: u.String(), Type: t, Element: element}
+		if seen[f] {
+			return
+		}
+
+		seen[f] = true
+		findings = append(findings, f)
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//head/script[@src]") {
+		if hasAttr(n, "async") || hasAttr(n, "defer") || !isClassicScript(n) {
+			continue
+		}
+
+		add(models.LoadingBlockingScript, "script", htmlquery.SelectAttr(n, "src"))
+	}
+
+	stylesheets := []*html.Node{}
+	for _, n := range htmlquery.Find(p.doc, "//head/link[@href]") {
+		rel := strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel")))
+		media := strings.TrimSpace(strings.ToLower(htmlquery.SelectAttr(n, "media")))
+		if slices.Contains(rel, "stylesheet") && media != "print" && !hasAttr(n, "disabled") {
+			stylesheets = append(stylesheets, n)
+		}
+	}
+
+	if len(stylesheets) > models.MaxHeadStylesheets {
+		for _, n := range stylesheets {
+			add(models.LoadingHeadStylesheet, "stylesheet", htmlquery.SelectAttr(n, "href"))
+		}
+	}
+
+	referenced := p.referencedURLs()
+	for _, n := range htmlquery.Find(p.doc, "//link[@href]") {
+		rel := strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel")))
+		as := strings.TrimSpace(strings.ToLower(htmlquery.SelectAttr(n, "as")))
+		if !slices.Contains(rel, "preload") || slices.Contains(rel, "stylesheet") || hasAttr(n, "onload") {
+			continue
+		}
+
+		if as == "" || as == "font" || as == "fetch" {
+			continue
+		}
+
+		u, err := urlutils.AbsoluteURL(htmlquery.SelectAttr(n, "href"), p.doc, p.ParsedURL)
+		if err != nil || referenced[u.String()] {
+			continue
+		}
+
+		add(models.LoadingUnusedPreload, "preload", u.String())
+	}
+
+	// The images are collected walking the body, as their order in the document is used to
+	// tell apart the images above and below the fold. Tracking pixels are ignored.
+	images := []*html.Node{}
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "img" {
+			if p.imageURL(n) != "" && imageDimension(n, "width") != 1 && imageDimension(n, "height") != 1 {
+				images = append(images, n)
+			}
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+
+	if body := htmlquery.FindOne(p.doc, "//body"); body != nil {
+		walk(body)
+	}
+
+	var lcp *html.Node
+	for _, n := range images {
+		if hasAncestor(n, "header", "nav", "footer", "aside") {
+			continue
+		}
+
+		width, height := imageDimension(n, "width"), imageDimension(n, "height")
+		if (width > 0 && width < models.MinLCPImageSize) || (height > 0 && height < models.MinLCPImageSize) {
+			continue
+		}
+
+		lcp = n
+		break
+	}
+
+	if lcp != nil && isLazyLoaded(lcp) {
+		add(models.LoadingLazyLCPImage, "img", p.imageURL(lcp))
+	}
+
+	if len(images) > models.LazyLoadMinImages {
+		for _, n := range images[models.LazyLoadMinImages:] {
+			if n == lcp || isLazyLoaded(n) {
+				continue
+			}
+
+			add(models.LoadingEagerImage, "img", p.imageURL(n))
+		}
+	}
+
+	return findings
+}
+
+// referencedURLs returns the absolute URLs of the resources referenced in the page's scripts,
+// stylesheets, images, media, iframes and inline CSS. It is used to check if the preloaded
+// resources are used.
+func (p *Parser) referencedURLs() map[string]bool {
+	referenced := make(map[string]bool)
+	add := func(s string) {
+		u, err := urlutils.AbsoluteURL(strings.TrimSpace(s), p.doc, p.ParsedURL)
+		if err == nil {
+			referenced[u.String()] = true
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//script[@src] | //img[@src] | //source[@src] | //audio[@src] | //video[@src] | //iframe[@src] | //embed[@src] | //input[@src]") {
+		add(htmlquery.SelectAttr(n, "src"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//img[@srcset] | //source[@srcset]") {
+		for _, src := range p.parseSrcSet(htmlquery.SelectAttr(n, "srcset")) {
+			add(src)
+		}
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//video[@poster]") {
+		add(htmlquery.SelectAttr(n, "poster"))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//link[@href]") {
+		rel := strings.Fields(strings.ToLower(htmlquery.SelectAttr(n, "rel")))
+		if slices.Contains(rel, "stylesheet") || slices.Contains(rel, "icon") {
+			add(htmlquery.SelectAttr(n, "href"))
+		}
+	}
+
+	css := []string{}
+	for _, n := range htmlquery.Find(p.doc, "//style") {
+		css = append(css, htmlquery.InnerText(n))
+	}
+
+	for _, n := range htmlquery.Find(p.doc, "//*[@style]") {
+		css = append(css, htmlquery.SelectAttr(n, "style"))
+	}
+
+	for _, u := range ExtractURLsFromCSS(strings.Join(css, "\n")) {
+		add(u.String())
+	}
+
+	return referenced
+}
+
+// Extract the Open Graph and Twitter Card meta tags. Open Graph tags use the property
+// attribute and Twitter tags use the name attribute, but both are accepted for any of them.
+// ex. <meta property="og:title" content="Page Title"> <meta name="twitter:card" content="summary">
+func (p *Parser) htmlSocialTags() []models.SocialTag {
+	tags := []models.SocialTag{}
+	metas := htmlquery.Find(p.doc, "//head/meta[@content]")
+	for _, n := range metas {
+		property := htmlquery.SelectAttr(n, "property")
+		if property == "" {
+			property = htmlquery.SelectAttr(n, "name")
+		}
+
+		property = strings.ToLower(strings.TrimSpace(property))
+		if !strings.HasPrefix(property, "og:") && !strings.HasPrefix(property, "twitter:") {
+			continue
+		}
+
+		tags = append(tags, models.SocialTag{
+			Property: property,
+			Content:  strings.TrimSpace(htmlquery.SelectAttr(n, "content")),
+		})
+	}
+
+	return tags
+}
+
 // Return the html document
 // ex. <body>
 func (p *Parser) htmlBodyNode() *html.Node {
@@ -640,7 +940,69 @@ func (p *Parser) newLink(n *html.Node) (models.Link, error) {
 		NoFollow:  strings.Contains(rel, "nofollow"),
 		Sponsored: strings.Contains(rel, "sponsored"),
 		UGC:       strings.Contains(rel, "ugc"),
+		Position:  linkPosition(n, nil),
 	}
 
 	return l, nil
 }
+
+// hasAttr returns true if the html node has the attribute, even if it has no value.
+func hasAttr(n *html.Node, name string) bool {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasAncestor returns true if any of the node's ancestors is one of the elements.
+func hasAncestor(n *html.Node, elements ...string) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Type == html.ElementNode && slices.Contains(elements, p.Data) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isClassicScript returns true if the script element is a classic JavaScript script. Module
+// scripts are deferred by default and data blocks are not executed.
+func isClassicScript(n *html.Node) bool {
+	t := strings.TrimSpace(strings.ToLower(htmlquery.SelectAttr(n, "type")))
+
+	return t == "" || strings.Contains(t, "javascript") || strings.Contains(t, "ecmascript")
+}
+
+// isLazyLoaded returns true if the image has the loading attribute set to lazy.
+func isLazyLoaded(n *html.Node) bool {
+	return strings.TrimSpace(strings.ToLower(htmlquery.SelectAttr(n, "loading"))) == "lazy"
+}
+
+// imageDimension returns the value in pixels of the image's width or height attribute.
+// It returns 0 if the attribute is missing or it is not a number.
+func imageDimension(n *html.Node, attr string) int {
+	v := strings.TrimSuffix(strings.TrimSpace(htmlquery.SelectAttr(n, attr)), "px")
+	d, err := strconv.Atoi(v)
+	if err != nil {
+		return 0
+	}
+
+	return d
+}
+
+// imageURL returns the image's src attribute or its first srcset candidate if it doesn't have one.
+func (p *Parser) imageURL(n *html.Node) string {
+	if src := strings.TrimSpace(htmlquery.SelectAttr(n, "src")); src != "" {
+		return src
+	}
+
+	srcset := p.parseSrcSet(htmlquery.SelectAttr(n, "srcset"))
+	if len(srcset) > 0 {
+		return srcset[0]
+	}
+
+	return ""
+}
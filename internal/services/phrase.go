@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/issues/page"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	PhraseServiceRepository interface {
+		FindPhrases(projectId int64, kind string) []models.Phrase
+		SavePhrase(*models.Phrase) error
+		DeletePhrase(id, projectId int64) error
+	}
+
+	PhraseService struct {
+		repository PhraseServiceRepository
+	}
+)
+
+var (
+	// Error returned when the phrase's kind is not supported.
+	ErrPhraseKind = errors.New("phrase kind not supported")
+
+	// Error returned when the phrase's language is not a two or three letter language code.
+	ErrPhraseLang = errors.New("phrase language must be a language code such as en or es")
+
+	// Error returned when the phrase's text is empty.
+	ErrPhraseText = errors.New("phrase text must not be empty")
+)
+
+var langCodeRegex = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// defaultPhrases contains the default phrases of each kind by language.
+var defaultPhrases = map[string]map[string][]string{
+	models.PhraseGenericAnchor: page.DefaultGenericAnchors,
+	models.PhraseSoft404:       page.DefaultSoft404Patterns,
+}
+
+func NewPhraseService(r PhraseServiceRepository) *PhraseService {
+	return &PhraseService{
+		repository: r,
+	}
+}
+
+// IsPhraseKind returns true if the kind is one of the supported phrase kinds.
+func IsPhraseKind(kind string) bool {
+	_, ok := defaultPhrases[kind]
+	return ok
+}
+
+// GetPhrases returns the phrases of a kind added to the project.
+func (s *PhraseService) GetPhrases(projectId int64, kind string) []models.Phrase {
+	return s.repository.FindPhrases(projectId, kind)
+}
+
+// GetDefaultPhrases returns the default phrases of a kind sorted by language.
+func (s *PhraseService) GetDefaultPhrases(kind string) []models.Phrase {
+	phrases := []models.Phrase{}
+	for lang, texts := range defaultPhrases[kind] {
+		phrases = append(phrases, models.Phrase{Kind: kind, Lang: lang, Text: strings.Join(texts, ", ")})
+	}
+
+	sort.Slice(phrases, func(i, j int) bool {
+		return phrases[i].Lang < phrases[j].Lang
+	})
+
+	return phrases
+}
+
+// AddPhrase validates the phrase and stores it.
+func (s *PhraseService) AddPhrase(phrase *models.Phrase) error {
+	phrase.Lang = strings.ToLower(strings.TrimSpace(phrase.Lang))
+	phrase.Text = strings.TrimSpace(phrase.Text)
+
+	err := ValidatePhrase(phrase)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SavePhrase(phrase)
+}
+
+// DeletePhrase removes a phrase from the project.
+func (s *PhraseService) DeletePhrase(id, projectId int64) error {
+	return s.repository.DeletePhrase(id, projectId)
+}
+
+// ValidatePhrase checks the phrase has a supported kind, a valid language code and a text.
+func ValidatePhrase(phrase *models.Phrase) error {
+	if !IsPhraseKind(phrase.Kind) {
+		return ErrPhraseKind
+	}
+
+	if !langCodeRegex.MatchString(phrase.Lang) {
+		return ErrPhraseLang
+	}
+
+	if phrase.Text == "" {
+		return ErrPhraseText
+	}
+
+	return nil
+}
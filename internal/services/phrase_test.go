@@ -0,0 +1,30 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+func TestValidatePhrase(t *testing.T) {
+	table := []struct {
+		phrase models.Phrase
+		err    error
+	}{
+		{models.Phrase{Kind: models.PhraseGenericAnchor, Lang: "en", Text: "check it out"}, nil},
+		{models.Phrase{Kind: models.PhraseGenericAnchor, Lang: "ast", Text: "calca equí"}, nil},
+		{models.Phrase{Kind: models.PhraseSoft404, Lang: "en", Text: "gone fishing"}, nil},
+		{models.Phrase{Kind: "redirect", Lang: "en", Text: "gone fishing"}, services.ErrPhraseKind},
+		{models.Phrase{Kind: models.PhraseGenericAnchor, Lang: "en-us", Text: "check it out"}, services.ErrPhraseLang},
+		{models.Phrase{Kind: models.PhraseSoft404, Lang: "", Text: "gone fishing"}, services.ErrPhraseLang},
+		{models.Phrase{Kind: models.PhraseSoft404, Lang: "en"}, services.ErrPhraseText},
+	}
+
+	for _, v := range table {
+		err := services.ValidatePhrase(&v.phrase)
+		if err != v.err {
+			t.Errorf("ValidatePhrase %+v want: %v Got: %v", v.phrase, v.err, err)
+		}
+	}
+}
@@ -40,6 +40,7 @@ func NewRenderer(config *RendererConfig, translator RendererTranslator) (*Render
 		"total_time": r.totalTime,
 		"add":        r.add,
 		"to_kb":      r.toKByte,
+		"dict":       r.dict,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("renderer initialisation failed: %w", err)
@@ -71,6 +72,22 @@ func (r *Renderer) add(i ...int) int {
 	return total
 }
 
+// dict is a helper function that returns a map with the key and value pairs in values.
+// It is used to pass several values to a template. Keys that are not strings are ignored.
+func (r *Renderer) dict(values ...interface{}) map[string]interface{} {
+	d := make(map[string]interface{}, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		k, ok := values[i].(string)
+		if !ok {
+			continue
+		}
+
+		d[k] = values[i+1]
+	}
+
+	return d
+}
+
 // toKByte is a helper function that returns an int64 formated as KB.
 func (r *Renderer) toKByte(b int64) string {
 	v := b / (1 << 10)
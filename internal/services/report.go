@@ -3,15 +3,18 @@ package services
 import (
 	"errors"
 
+	"github.com/stjudewashere/seonaut/internal/issues/page"
 	"github.com/stjudewashere/seonaut/internal/models"
 )
 
 type (
 	ReportServiceRepository interface {
 		FindPageReportById(int) models.PageReport
-		FindErrorTypesByPage(int, int64) []string
+		FindErrorTypesByPage(int, int64) []models.IssueGroup
 		FindInLinks(string, int64, int) []models.InternalLink
 		FindPageReportsRedirectingToURL(string, int64, int) []models.PageReport
+		FindAnchorTexts(string, int64) []models.AnchorText
+		FindClickPath(pageReport *models.PageReport, cid int64) []models.PageReport
 		FindAllPageReportsByCrawlIdAndErrorType(int64, string) <-chan *models.PageReport
 		FindAllPageReportsByCrawlId(int64) <-chan *models.PageReport
 		FindSitemapPageReports(int64) <-chan *models.PageReport
@@ -26,6 +29,12 @@ type (
 		FindPageReportIframes(pageReport *models.PageReport, cid int64) []string
 		FindPageReportImages(pageReport *models.PageReport, cid int64) []models.Image
 		FindPageReportHreflangs(pageReport *models.PageReport, cid int64) []models.Hreflang
+		FindPageReportStructuredData(pageReport *models.PageReport, cid int64) []models.StructuredData
+		FindPageReportSocialTags(pageReport *models.PageReport, cid int64) []models.SocialTag
+		FindPageReportExtractions(pageReport *models.PageReport, cid int64) []models.Extraction
+		FindPageReportsExtractions(ids []int64, cid int64) map[int64][]models.Extraction
+		FindPageReportMixedContent(pageReport *models.PageReport, cid int64) []models.MixedContent
+		FindPageReportLoadingFindings(pageReport *models.PageReport, cid int64) []models.LoadingFinding
 
 		GetNumberOfPagesForPageReport(cid int64, term string) int
 		GetNumberOfPagesForInlinks(*models.PageReport, int64) int
@@ -54,6 +63,12 @@ func (s *ReportService) GetPageReport(rid int, crawlId int64, tab string, page i
 	v.PageReport.Hreflangs = s.repository.FindPageReportHreflangs(&v.PageReport, crawlId)
 
 	switch tab {
+	case "details":
+		v.PageReport.SocialTags = s.repository.FindPageReportSocialTags(&v.PageReport, crawlId)
+		v.PageReport.Extractions = s.repository.FindPageReportExtractions(&v.PageReport, crawlId)
+		v.ClickPath = s.repository.FindClickPath(&v.PageReport, crawlId)
+		v.PageReport.MixedContent = s.repository.FindPageReportMixedContent(&v.PageReport, crawlId)
+		v.PageReport.LoadingFindings = s.repository.FindPageReportLoadingFindings(&v.PageReport, crawlId)
 	case "internal":
 		v.PageReport.InternalLinks = s.repository.FindLinks(&v.PageReport, crawlId, page)
 	case "external":
@@ -62,6 +77,8 @@ func (s *ReportService) GetPageReport(rid int, crawlId int64, tab string, page i
 		v.InLinks = s.repository.FindInLinks(v.PageReport.URL, crawlId, page)
 	case "redirections":
 		v.Redirects = s.repository.FindPageReportsRedirectingToURL(v.PageReport.URL, crawlId, page)
+	case "anchors":
+		v.Anchors = s.repository.FindAnchorTexts(v.PageReport.URL, crawlId)
 	case "styles":
 		v.PageReport.Styles = s.repository.FindPageReportStyles(&v.PageReport, crawlId)
 	case "scripts":
@@ -74,6 +91,11 @@ func (s *ReportService) GetPageReport(rid int, crawlId int64, tab string, page i
 		v.PageReport.Iframes = s.repository.FindPageReportIframes(&v.PageReport, crawlId)
 	case "images":
 		v.PageReport.Images = s.repository.FindPageReportImages(&v.PageReport, crawlId)
+		for i := range v.PageReport.Images {
+			v.PageReport.Images[i].Issues = append(v.PageReport.Images[i].Issues, ImageIssues(v.PageReport.Images[i])...)
+		}
+	case "structured_data":
+		v.PageReport.StructuredData = s.repository.FindPageReportStructuredData(&v.PageReport, crawlId)
 	}
 
 	v.Paginator = s.getPaginator(&v.PageReport, crawlId, tab, page)
@@ -144,6 +166,16 @@ func (s *ReportService) GetPaginatedReports(crawlId int64, currentPage int, term
 		PageReports: s.repository.FindPaginatedPageReports(crawlId, currentPage, term),
 	}
 
+	ids := []int64{}
+	for _, pr := range paginatorView.PageReports {
+		ids = append(ids, pr.Id)
+	}
+
+	extractions := s.repository.FindPageReportsExtractions(ids, crawlId)
+	for i := range paginatorView.PageReports {
+		paginatorView.PageReports[i].Extractions = extractions[paginatorView.PageReports[i].Id]
+	}
+
 	return paginatorView, nil
 }
 
@@ -151,3 +183,24 @@ func (s *ReportService) GetPaginatedReports(crawlId int64, currentPage int, term
 func (s *ReportService) GetSitemapPageReports(crawlId int64) <-chan *models.PageReport {
 	return s.repository.FindSitemapPageReports(crawlId)
 }
+
+// ImageIssues returns the names of the issue types of an image in the page. The intrinsic size
+// of the crawled image is compared with its width and height attributes, and the srcset
+// candidates returning a 4xx or 5xx status code are broken.
+func ImageIssues(i models.Image) []string {
+	issues := []string{}
+
+	if page.IsOversizedImage(i) {
+		issues = append(issues, "ERROR_OVERSIZED_IMAGE")
+	}
+
+	if page.IsDistortedImage(i) {
+		issues = append(issues, "ERROR_DISTORTED_IMAGE")
+	}
+
+	if i.Srcset && i.StatusCode >= 400 {
+		issues = append(issues, "ERROR_BROKEN_SRCSET")
+	}
+
+	return issues
+}
@@ -24,16 +24,41 @@ type (
 		repository         ReportManagerRepository
 		pageCallbacks      []*models.PageIssueReporter
 		multipageCallbacks []models.MultipageCallback
+		disabledTypes      map[int]bool
 	}
 )
 
 // Create a new ReportManager with no issue reporters.
 func NewReportManager(r ReportManagerRepository) *ReportManager {
 	return &ReportManager{
-		repository: r,
+		repository:    r,
+		disabledTypes: make(map[int]bool),
 	}
 }
 
+// Copy returns a new ReportManager with the same repository and issue reporters. Reporters
+// added to the copy, such as the project's custom issue reporters, won't be added to the
+// original ReportManager.
+func (rm *ReportManager) Copy() *ReportManager {
+	disabledTypes := make(map[int]bool)
+	for t := range rm.disabledTypes {
+		disabledTypes[t] = true
+	}
+
+	return &ReportManager{
+		repository:         rm.repository,
+		pageCallbacks:      append([]*models.PageIssueReporter{}, rm.pageCallbacks...),
+		multipageCallbacks: append([]models.MultipageCallback{}, rm.multipageCallbacks...),
+		disabledTypes:      disabledTypes,
+	}
+}
+
+// DisableIssueType disables an issue type in the ReportManager.
+// The issue reporters of a disabled type won't create any issue.
+func (rm *ReportManager) DisableIssueType(errorType int) {
+	rm.disabledTypes[errorType] = true
+}
+
 // Add an page issue reporter to the ReportManager.
 // It will be used to create issues on each crawled page.
 func (rm *ReportManager) AddPageReporter(reporter *models.PageIssueReporter) {
@@ -60,6 +85,10 @@ func (r *ReportManager) CreatePageIssues(p *models.PageReport, htmlNode *html.No
 	}()
 
 	for _, c := range r.pageCallbacks {
+		if r.disabledTypes[c.ErrorType] {
+			continue
+		}
+
 		if c.Callback(p, htmlNode, header) {
 			iStream <- &models.Issue{
 				PageReportId: p.Id,
@@ -87,7 +116,14 @@ func (r *ReportManager) CreateMultipageIssues(crawl *models.Crawl) {
 
 	for _, callback := range r.multipageCallbacks {
 		reporter := callback(crawl)
+
+		// The stream is drained even if the issue type is disabled,
+		// so the reporter's goroutine can finish.
 		for pid := range reporter.Pstream {
+			if r.disabledTypes[reporter.ErrorType] {
+				continue
+			}
+
 			iStream <- &models.Issue{
 				PageReportId: pid,
 				CrawlId:      crawl.Id,
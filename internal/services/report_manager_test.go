@@ -150,3 +150,77 @@ func TestCreateMultiPageIssues(t *testing.T) {
 		t.Errorf("CreatePageIsssues: reporterCrawlId %d != %d", issue.ErrorType, reporterErrorType)
 	}
 }
+
+// Add a PageReporter to a copy of the ReportManager and test if the issue is only
+// created by the copy.
+func TestCopyReportManager(t *testing.T) {
+	repository := &reportManagerTestRepository{}
+	service := services.NewReportManager(repository)
+	serviceCopy := service.Copy()
+
+	// Add a new PageReporter that detects an issue to the copy.
+	serviceCopy.AddPageReporter(
+		&models.PageIssueReporter{
+			ErrorType: reporterErrorType,
+			Callback: func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+				return true
+			},
+		})
+
+	pageReport := &models.PageReport{Id: pageReportId}
+	crawl := &models.Crawl{Id: reporterCrawlId}
+
+	// The original ReportManager doesn't have the reporter so no issue is created.
+	service.CreatePageIssues(pageReport, &html.Node{}, &http.Header{}, crawl)
+	if len(repository.Issues) != 0 {
+		t.Errorf("Copy: original ReportManager %d != 0", len(repository.Issues))
+	}
+
+	serviceCopy.CreatePageIssues(pageReport, &html.Node{}, &http.Header{}, crawl)
+	if len(repository.Issues) != 1 {
+		t.Errorf("Copy: ReportManager copy %d != 1", len(repository.Issues))
+	}
+}
+
+// Disable the issue type of a PageReporter and a MultipageReporter and test that
+// no issues are created.
+func TestDisableIssueType(t *testing.T) {
+	repository := &reportManagerTestRepository{}
+	service := services.NewReportManager(repository)
+
+	service.AddPageReporter(
+		&models.PageIssueReporter{
+			ErrorType: reporterErrorType,
+			Callback: func(pageReport *models.PageReport, htmlNode *html.Node, header *http.Header) bool {
+				return true
+			},
+		})
+
+	service.AddMultipageReporter(
+		func(c *models.Crawl) *models.MultipageIssueReporter {
+			stream := make(chan int64)
+
+			go func() {
+				stream <- pageReportId
+				close(stream)
+			}()
+
+			return &models.MultipageIssueReporter{
+				Pstream:   stream,
+				ErrorType: reporterErrorType,
+			}
+		},
+	)
+
+	service.DisableIssueType(reporterErrorType)
+
+	pageReport := &models.PageReport{Id: pageReportId}
+	crawl := &models.Crawl{Id: reporterCrawlId}
+
+	service.CreatePageIssues(pageReport, &html.Node{}, &http.Header{}, crawl)
+	service.CreateMultipageIssues(crawl)
+
+	if len(repository.Issues) != 0 {
+		t.Errorf("DisableIssueType: %d != 0", len(repository.Issues))
+	}
+}
@@ -66,8 +66,8 @@ func (s *reportTestRepository) FindPageReportById(id int) models.PageReport {
 	return models.PageReport{Id: reportId}
 }
 
-func (s *reportTestRepository) FindErrorTypesByPage(reportId int, crawlId int64) []string {
-	return []string{errorType}
+func (s *reportTestRepository) FindErrorTypesByPage(reportId int, crawlId int64) []models.IssueGroup {
+	return []models.IssueGroup{{ErrorType: errorType}}
 }
 
 func (s *reportTestRepository) FindInLinks(u string, id int64, page int) []models.InternalLink {
@@ -82,6 +82,14 @@ func (s *reportTestRepository) FindPageReportsRedirectingToURL(u string, id int6
 	return []models.PageReport{{Id: reportId}}
 }
 
+func (s *reportTestRepository) FindAnchorTexts(u string, id int64) []models.AnchorText {
+	return []models.AnchorText{}
+}
+
+func (s *reportTestRepository) FindClickPath(pageReport *models.PageReport, cid int64) []models.PageReport {
+	return []models.PageReport{}
+}
+
 func (s *reportTestRepository) FindAllPageReportsByCrawlIdAndErrorType(id int64, e string) <-chan *models.PageReport {
 	prStream := make(chan *models.PageReport)
 	go func() {
@@ -150,6 +158,26 @@ func (s *reportTestRepository) FindPageReportImages(pageReport *models.PageRepor
 func (s *reportTestRepository) FindPageReportHreflangs(pageReport *models.PageReport, cid int64) []models.Hreflang {
 	return []models.Hreflang{}
 }
+func (s *reportTestRepository) FindPageReportStructuredData(pageReport *models.PageReport, cid int64) []models.StructuredData {
+	return []models.StructuredData{}
+}
+func (s *reportTestRepository) FindPageReportSocialTags(pageReport *models.PageReport, cid int64) []models.SocialTag {
+	return []models.SocialTag{}
+}
+func (s *reportTestRepository) FindPageReportExtractions(pageReport *models.PageReport, cid int64) []models.Extraction {
+	return []models.Extraction{}
+}
+func (s *reportTestRepository) FindPageReportsExtractions(ids []int64, cid int64) map[int64][]models.Extraction {
+	return map[int64][]models.Extraction{}
+}
+
+func (s *reportTestRepository) FindPageReportMixedContent(pageReport *models.PageReport, cid int64) []models.MixedContent {
+	return []models.MixedContent{}
+}
+
+func (s *reportTestRepository) FindPageReportLoadingFindings(pageReport *models.PageReport, cid int64) []models.LoadingFinding {
+	return []models.LoadingFinding{}
+}
 
 var reportservice = services.NewReportService(&reportTestRepository{})
 
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	SiteStructureServiceRepository interface {
+		FindSiteStructurePageReports(cid int64) []models.PageReport
+	}
+
+	SiteStructureService struct {
+		repository SiteStructureServiceRepository
+	}
+)
+
+func NewSiteStructureService(r SiteStructureServiceRepository) *SiteStructureService {
+	return &SiteStructureService{
+		repository: r,
+	}
+}
+
+// GetSiteStructure returns the directory tree of the crawl's HTML pages. The first level
+// of the tree contains the crawled hosts and the following levels contain the URL path segments.
+// URLs with a query string are added as children of the URL's path.
+func (s *SiteStructureService) GetSiteStructure(crawlId int64) *models.SiteStructureNode {
+	root := &models.SiteStructureNode{}
+	children := make(map[*models.SiteStructureNode]map[string]*models.SiteStructureNode)
+
+	// child returns the node's child with the specified name, adding it if it doesn't exist.
+	child := func(n *models.SiteStructureNode, name string) *models.SiteStructureNode {
+		if _, ok := children[n]; !ok {
+			children[n] = make(map[string]*models.SiteStructureNode)
+		}
+
+		c, ok := children[n][name]
+		if !ok {
+			c = &models.SiteStructureNode{Name: name}
+			children[n][name] = c
+			n.Children = append(n.Children, c)
+		}
+
+		return c
+	}
+
+	for _, p := range s.repository.FindSiteStructurePageReports(crawlId) {
+		u, err := url.Parse(p.URL)
+		if err != nil {
+			continue
+		}
+
+		node := child(root, u.Scheme+"://"+u.Host)
+		node.Pages++
+		root.Pages++
+
+		segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+		if segments[0] == "" {
+			segments = nil
+		}
+
+		if u.RawQuery != "" {
+			segments = append(segments, "?"+u.RawQuery)
+		}
+
+		for _, segment := range segments {
+			node = child(node, segment)
+			node.Pages++
+		}
+
+		node.PageReportId = p.Id
+		node.StatusCode = p.StatusCode
+		node.LinkScore = p.LinkScore
+	}
+
+	sortSiteStructure(root)
+
+	return root
+}
+
+// sortSiteStructure sorts the children of the node and its subdirectories by name.
+func sortSiteStructure(n *models.SiteStructureNode) {
+	sort.Slice(n.Children, func(i, j int) bool {
+		return n.Children[i].Name < n.Children[j].Name
+	})
+
+	for _, c := range n.Children {
+		sortSiteStructure(c)
+	}
+}
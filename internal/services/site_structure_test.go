@@ -0,0 +1,50 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+type siteStructureTestRepository struct{}
+
+func (r *siteStructureTestRepository) FindSiteStructurePageReports(cid int64) []models.PageReport {
+	return []models.PageReport{
+		{Id: 1, URL: "https://example.com/", StatusCode: 200},
+		{Id: 2, URL: "https://example.com/blog/", StatusCode: 200},
+		{Id: 3, URL: "https://example.com/blog/post-2", StatusCode: 200},
+		{Id: 4, URL: "https://example.com/blog/post-1", StatusCode: 404},
+		{Id: 5, URL: "https://example.com/blog/?page=2", StatusCode: 200},
+	}
+}
+
+func TestGetSiteStructure(t *testing.T) {
+	s := services.NewSiteStructureService(&siteStructureTestRepository{})
+	root := s.GetSiteStructure(1)
+
+	if root.Pages != 5 || len(root.Children) != 1 {
+		t.Fatalf("GetSiteStructure root want: 5 pages and 1 host Got: %d pages and %d hosts", root.Pages, len(root.Children))
+	}
+
+	host := root.Children[0]
+	if host.Name != "https://example.com" || host.PageReportId != 1 {
+		t.Errorf("GetSiteStructure host want: https://example.com with id 1 Got: %s with id %d", host.Name, host.PageReportId)
+	}
+
+	blog := host.Children[0]
+	if blog.Name != "blog" || blog.Pages != 4 || blog.PageReportId != 2 {
+		t.Errorf("GetSiteStructure blog want: blog with 4 pages and id 2 Got: %s with %d pages and id %d", blog.Name, blog.Pages, blog.PageReportId)
+	}
+
+	want := []string{"?page=2", "post-1", "post-2"}
+	if len(blog.Children) != len(want) {
+		t.Fatalf("GetSiteStructure blog children want: %d Got: %d", len(want), len(blog.Children))
+	}
+
+	for i, c := range blog.Children {
+		if c.Name != want[i] {
+			t.Errorf("GetSiteStructure blog child %d want: %s Got: %s", i, want[i], c.Name)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/url"
+
+	"github.com/stjudewashere/seonaut/internal/crawler"
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+// fetchNotFoundReference requests a random URL that doesn't exist in the site and returns
+// the SimHash and number of words of its main content. The response is used as a reference
+// of the site's not found page, regardless of its status code.
+func fetchNotFoundReference(client crawler.Client, u *url.URL) models.NotFoundReference {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		log.Printf("fetchNotFoundReference: %v\n", err)
+		return models.NotFoundReference{}
+	}
+
+	notFoundURL := u.ResolveReference(&url.URL{Path: "/seonaut-not-found-" + hex.EncodeToString(b)})
+
+	res, err := client.Get(notFoundURL.String())
+	if err != nil {
+		log.Printf("fetchNotFoundReference: %v\n", err)
+		return models.NotFoundReference{}
+	}
+
+	pageReport, _, err := NewFromHTTPResponse(res.Response)
+	if err != nil {
+		log.Printf("fetchNotFoundReference: %v\n", err)
+		return models.NotFoundReference{}
+	}
+
+	return models.NotFoundReference{
+		SimHash:   pageReport.SimHash,
+		MainWords: pageReport.MainWords,
+	}
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+// requiredProperties contains a subset of the schema.org types with the properties that are
+// required in each of them. Each group of properties is satisfied if the entity has a non-empty
+// value for at least one of the properties in the group.
+var requiredProperties = map[string][][]string{
+	"Product":        {{"name"}, {"offers", "review", "aggregateRating"}},
+	"Article":        {{"headline"}, {"author"}, {"datePublished"}},
+	"NewsArticle":    {{"headline"}, {"author"}, {"datePublished"}},
+	"BlogPosting":    {{"headline"}, {"author"}, {"datePublished"}},
+	"BreadcrumbList": {{"itemListElement"}},
+	"FAQPage":        {{"mainEntity"}},
+	"Organization":   {{"name"}, {"url"}},
+}
+
+// Returns all the structured data entities found in the document, including JSON-LD,
+// Microdata and RDFa. Each entity is validated against the required properties of its type.
+func (p *Parser) structuredData() []models.StructuredData {
+	entities := []models.StructuredData{}
+	entities = append(entities, p.jsonLD()...)
+	entities = append(entities, p.microdata()...)
+	entities = append(entities, p.rdfa()...)
+
+	for i := range entities {
+		entities[i].Missing = missingProperties(&entities[i])
+	}
+
+	return entities
+}
+
+// Extract JSON-LD entities from the ld+json script elements. Scripts containing invalid JSON
+// are returned as an entity with the parsing error.
+// ex. <script type="application/ld+json">{"@context": "https://schema.org", "@type": "Organization"}</script>
+func (p *Parser) jsonLD() []models.StructuredData {
+	entities := []models.StructuredData{}
+	scripts := htmlquery.Find(p.doc, "//script[starts-with(@type, \"application/ld+json\")]")
+	for _, n := range scripts {
+		var data interface{}
+		err := json.Unmarshal([]byte(htmlquery.InnerText(n)), &data)
+		if err != nil {
+			entities = append(entities, models.StructuredData{
+				Format: models.StructuredDataJSONLD,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		entities = append(entities, jsonLDEntities(data)...)
+	}
+
+	return entities
+}
+
+// Extract the top level Microdata items.
+// ex. <div itemscope itemtype="https://schema.org/Product"><span itemprop="name">Name</span></div>
+func (p *Parser) microdata() []models.StructuredData {
+	entities := []models.StructuredData{}
+	items := htmlquery.Find(p.doc, "//*[@itemscope and not(@itemprop)]")
+	for _, n := range items {
+		entities = append(entities, models.StructuredData{
+			Format:     models.StructuredDataMicrodata,
+			Type:       schemaType(htmlquery.SelectAttr(n, "itemtype")),
+			Properties: itemProperties(n, "itemprop", "itemscope", "itemtype"),
+		})
+	}
+
+	return entities
+}
+
+// Extract the top level RDFa items.
+// ex. <div vocab="https://schema.org/" typeof="Product"><span property="name">Name</span></div>
+func (p *Parser) rdfa() []models.StructuredData {
+	entities := []models.StructuredData{}
+	items := htmlquery.Find(p.doc, "//*[@typeof and not(@property)]")
+	for _, n := range items {
+		entities = append(entities, models.StructuredData{
+			Format:     models.StructuredDataRDFa,
+			Type:       schemaType(htmlquery.SelectAttr(n, "typeof")),
+			Properties: itemProperties(n, "property", "typeof", "typeof"),
+		})
+	}
+
+	return entities
+}
+
+// jsonLDEntities returns the entities found in the decoded JSON-LD data. It handles arrays
+// of entities as well as the @graph property.
+func jsonLDEntities(data interface{}) []models.StructuredData {
+	entities := []models.StructuredData{}
+
+	switch v := data.(type) {
+	case []interface{}:
+		for _, e := range v {
+			entities = append(entities, jsonLDEntities(e)...)
+		}
+	case map[string]interface{}:
+		if graph, ok := v["@graph"]; ok {
+			entities = append(entities, jsonLDEntities(graph)...)
+		}
+
+		t := jsonLDType(v["@type"])
+		if t == "" {
+			return entities
+		}
+
+		properties := make(map[string]interface{})
+		for k, p := range v {
+			if strings.HasPrefix(k, "@") {
+				continue
+			}
+			properties[k] = p
+		}
+
+		entities = append(entities, models.StructuredData{
+			Format:     models.StructuredDataJSONLD,
+			Type:       t,
+			Properties: properties,
+		})
+	}
+
+	return entities
+}
+
+// jsonLDType returns the entity type from a JSON-LD @type value, which can be
+// a string or an array of strings. In case of an array it returns the first type.
+func jsonLDType(t interface{}) string {
+	switch v := t.(type) {
+	case string:
+		return schemaType(v)
+	case []interface{}:
+		for _, i := range v {
+			if s, ok := i.(string); ok {
+				return schemaType(s)
+			}
+		}
+	}
+
+	return ""
+}
+
+// schemaType removes the vocabulary URL or prefix from a type.
+// ex. "https://schema.org/Product" and "schema:Product" return "Product".
+func schemaType(t string) string {
+	t = strings.TrimSpace(strings.Fields(t + " ")[0])
+	if i := strings.LastIndexAny(t, "/:"); i >= 0 {
+		t = t[i+1:]
+	}
+
+	return t
+}
+
+// itemProperties returns the properties of a Microdata or RDFa item. The propAttr parameter is
+// the name of the attribute used to define properties, scopeAttr the attribute that defines a new
+// item and typeAttr the attribute containing the item's type. Nested items are returned as maps.
+func itemProperties(n *html.Node, propAttr, scopeAttr, typeAttr string) map[string]interface{} {
+	properties := make(map[string]interface{})
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+
+			prop := htmlquery.SelectAttr(c, propAttr)
+			nested := htmlquery.ExistsAttr(c, scopeAttr)
+
+			if prop != "" {
+				var value interface{}
+				if nested {
+					nestedProperties := itemProperties(c, propAttr, scopeAttr, typeAttr)
+					nestedProperties["@type"] = schemaType(htmlquery.SelectAttr(c, typeAttr))
+					value = nestedProperties
+				} else {
+					value = itemPropertyValue(c)
+				}
+
+				for _, name := range strings.Fields(prop) {
+					addProperty(properties, schemaType(name), value)
+				}
+			}
+
+			if !nested {
+				walk(c)
+			}
+		}
+	}
+
+	walk(n)
+
+	return properties
+}
+
+// itemPropertyValue returns the value of a Microdata or RDFa property element
+// depending on the element type.
+func itemPropertyValue(n *html.Node) string {
+	if htmlquery.ExistsAttr(n, "content") {
+		return htmlquery.SelectAttr(n, "content")
+	}
+
+	switch n.Data {
+	case "a", "area", "link":
+		return htmlquery.SelectAttr(n, "href")
+	case "img", "audio", "video", "source", "iframe", "embed", "track":
+		return htmlquery.SelectAttr(n, "src")
+	case "object":
+		return htmlquery.SelectAttr(n, "data")
+	case "data", "meter":
+		return htmlquery.SelectAttr(n, "value")
+	case "time":
+		if htmlquery.ExistsAttr(n, "datetime") {
+			return htmlquery.SelectAttr(n, "datetime")
+		}
+	}
+
+	if htmlquery.ExistsAttr(n, "resource") {
+		return htmlquery.SelectAttr(n, "resource")
+	}
+
+	return strings.TrimSpace(htmlquery.InnerText(n))
+}
+
+// addProperty adds a value to the properties map. If the property already exists
+// its value is converted into a slice containing all the values.
+func addProperty(properties map[string]interface{}, name string, value interface{}) {
+	current, ok := properties[name]
+	if !ok {
+		properties[name] = value
+		return
+	}
+
+	if values, ok := current.([]interface{}); ok {
+		properties[name] = append(values, value)
+		return
+	}
+
+	properties[name] = []interface{}{current, value}
+}
+
+// missingProperties returns the required properties that are missing in the entity.
+// In case a group of properties can be satisfied by any of them, they are returned
+// joined by "or".
+func missingProperties(e *models.StructuredData) []string {
+	missing := []string{}
+	groups, ok := requiredProperties[e.Type]
+	if !ok {
+		return missing
+	}
+
+	for _, group := range groups {
+		found := false
+		for _, p := range group {
+			if !emptyProperty(e.Properties[p]) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, strings.Join(group, " or "))
+		}
+	}
+
+	return missing
+}
+
+// emptyProperty returns true if the property value is nil, an empty string
+// or an empty slice or map.
+func emptyProperty(v interface{}) bool {
+	switch p := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(p) == ""
+	case []interface{}:
+		return len(p) == 0
+	case map[string]interface{}:
+		return len(p) == 0
+	}
+
+	return false
+}
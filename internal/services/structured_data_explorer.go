@@ -0,0 +1,55 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+)
+
+type (
+	StructuredDataServiceRepository interface {
+		FindStructuredDataTypes(crawlId int64) []string
+		GetNumberOfPagesForStructuredData(crawlId int64, t string, invalid bool) int
+		FindStructuredData(crawlId int64, p int, t string, invalid bool) []models.StructuredDataEntity
+	}
+
+	StructuredDataService struct {
+		repository StructuredDataServiceRepository
+	}
+)
+
+func NewStructuredDataService(r StructuredDataServiceRepository) *StructuredDataService {
+	return &StructuredDataService{
+		repository: r,
+	}
+}
+
+// GetPaginatedStructuredData returns a StructuredDataView with the crawl's structured data
+// entities of type t, or of any type if t is empty. If invalid is true only the entities with
+// validation errors are listed. The view's ProjectView is left empty.
+func (s *StructuredDataService) GetPaginatedStructuredData(crawlId int64, currentPage int, t string, invalid bool) (models.StructuredDataView, error) {
+	paginator := models.Paginator{
+		TotalPages:  s.repository.GetNumberOfPagesForStructuredData(crawlId, t, invalid),
+		CurrentPage: currentPage,
+	}
+
+	if currentPage < 1 || (currentPage > paginator.TotalPages && currentPage > 1) {
+		return models.StructuredDataView{}, errors.New("page out of bounds")
+	}
+
+	if currentPage < paginator.TotalPages {
+		paginator.NextPage = currentPage + 1
+	}
+
+	if currentPage > 1 {
+		paginator.PreviousPage = currentPage - 1
+	}
+
+	return models.StructuredDataView{
+		Paginator: paginator,
+		Entities:  s.repository.FindStructuredData(crawlId, currentPage, t, invalid),
+		Types:     s.repository.FindStructuredDataTypes(crawlId),
+		Type:      t,
+		Invalid:   invalid,
+	}, nil
+}
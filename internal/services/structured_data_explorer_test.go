@@ -0,0 +1,58 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stjudewashere/seonaut/internal/models"
+	"github.com/stjudewashere/seonaut/internal/services"
+)
+
+// structuredDataTestRepository stores the filter used to find the entities.
+type structuredDataTestRepository struct {
+	t       string
+	invalid bool
+}
+
+func (r *structuredDataTestRepository) FindStructuredDataTypes(crawlId int64) []string {
+	return []string{"Article", "Product"}
+}
+
+func (r *structuredDataTestRepository) GetNumberOfPagesForStructuredData(crawlId int64, t string, invalid bool) int {
+	return 2
+}
+
+func (r *structuredDataTestRepository) FindStructuredData(crawlId int64, p int, t string, invalid bool) []models.StructuredDataEntity {
+	r.t = t
+	r.invalid = invalid
+	return []models.StructuredDataEntity{
+		{PageReportId: 1, URL: "https://example.com/", StructuredData: models.StructuredData{Type: t}},
+	}
+}
+
+func TestGetPaginatedStructuredData(t *testing.T) {
+	r := &structuredDataTestRepository{}
+	s := services.NewStructuredDataService(r)
+
+	view, err := s.GetPaginatedStructuredData(1, 1, "Product", true)
+	if err != nil {
+		t.Fatalf("GetPaginatedStructuredData error: %v", err)
+	}
+
+	if r.t != "Product" || !r.invalid {
+		t.Errorf("GetPaginatedStructuredData filter want: Product true Got: %s %v", r.t, r.invalid)
+	}
+
+	if view.Type != "Product" || !view.Invalid || len(view.Types) != 2 || len(view.Entities) != 1 {
+		t.Errorf("GetPaginatedStructuredData unexpected view: %+v", view)
+	}
+
+	if view.Paginator.NextPage != 2 || view.Paginator.PreviousPage != 0 {
+		t.Errorf("GetPaginatedStructuredData paginator want next 2 prev 0 Got: %+v", view.Paginator)
+	}
+
+	for _, p := range []int{0, 3} {
+		if _, err := s.GetPaginatedStructuredData(1, p, "", false); err == nil {
+			t.Errorf("GetPaginatedStructuredData page %d want error", p)
+		}
+	}
+}
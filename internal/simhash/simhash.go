@@ -0,0 +1,57 @@
+// Package simhash computes the SimHash of texts, which is used to find similar pages.
+package simhash
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// Number of words in each of the shingles used to compute the SimHash.
+const shingleSize = 3
+
+// Hash returns the 64 bit SimHash of the word shingles. Similar texts have SimHashes that
+// differ in a small number of bits. If the text is shorter than a shingle the words are used
+// as a single shingle, and texts without words return 0.
+func Hash(words []string) uint64 {
+	if len(words) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	add := func(shingle []string) {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(shingle, " ")))
+		sum := h.Sum64()
+
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	if len(words) < shingleSize {
+		add(words)
+	}
+
+	for i := 0; i+shingleSize <= len(words); i++ {
+		add(words[i : i+shingleSize])
+	}
+
+	var hash uint64
+	for i := 0; i < 64; i++ {
+		if weights[i] > 0 {
+			hash |= 1 << uint(i)
+		}
+	}
+
+	return hash
+}
+
+// Similarity returns the percentage of equal bits in two SimHashes.
+func Similarity(a, b uint64) int {
+	return (64 - bits.OnesCount64(a^b)) * 100 / 64
+}
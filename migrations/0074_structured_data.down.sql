@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `structured_data`;
+
+DELETE FROM issue_types WHERE id = 80;
+DELETE FROM issue_types WHERE id = 81;
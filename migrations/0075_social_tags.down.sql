@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS `social_tags`;
+
+DELETE FROM issue_types WHERE id = 82;
+DELETE FROM issue_types WHERE id = 83;
+DELETE FROM issue_types WHERE id = 84;
+DELETE FROM issue_types WHERE id = 85;
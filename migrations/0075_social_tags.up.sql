@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS `social_tags` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `property` varchar(256) NOT NULL DEFAULT '',
+  `content` varchar(2048) NOT NULL DEFAULT '',
+  `content_hash` char(64) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `social_tags_pagereport` (`pagereport_id`),
+  KEY `social_tags_crawl` (`crawl_id`),
+  KEY `social_tags_content_hash` (`content_hash`),
+  CONSTRAINT `social_tags_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `social_tags_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+INSERT INTO issue_types (id, type, priority) VALUES(82, "ERROR_MISSING_OPEN_GRAPH", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(83, "ERROR_RELATIVE_OPEN_GRAPH_IMAGE", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(84, "ERROR_BROKEN_OPEN_GRAPH_IMAGE", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(85, "ERROR_OPEN_GRAPH_URL_MISMATCH", 3);
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS `extraction_rules` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `name` varchar(256) NOT NULL DEFAULT '',
+  `type` varchar(16) NOT NULL DEFAULT '',
+  `expression` varchar(2048) NOT NULL DEFAULT '',
+  `mode` varchar(16) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `extraction_rules_project` (`project_id`),
+  CONSTRAINT `extraction_rules_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `extractions` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `rule_id` int unsigned NOT NULL,
+  `value` text,
+  PRIMARY KEY (`id`),
+  KEY `extractions_pagereport` (`pagereport_id`),
+  KEY `extractions_crawl` (`crawl_id`),
+  KEY `extractions_rule` (`rule_id`),
+  CONSTRAINT `extractions_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `extractions_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `extractions_rule` FOREIGN KEY (`rule_id`) REFERENCES `extraction_rules` (`id`) ON DELETE CASCADE
+);
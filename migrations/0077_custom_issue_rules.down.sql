@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `custom_issue_rules`;
+DELETE FROM `issue_types` WHERE `project_id` IS NOT NULL;
+ALTER TABLE `issue_types` DROP FOREIGN KEY `issue_types_project`;
+ALTER TABLE `issue_types` DROP COLUMN `project_id`;
@@ -0,0 +1,21 @@
+ALTER TABLE `issue_types` ADD COLUMN `project_id` int unsigned DEFAULT NULL;
+ALTER TABLE `issue_types` ADD CONSTRAINT `issue_types_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE;
+
+-- Custom issue types are created by the users, leave room for the built-in issue types.
+ALTER TABLE `issue_types` AUTO_INCREMENT = 10000;
+
+CREATE TABLE IF NOT EXISTS `custom_issue_rules` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `issue_type_id` int unsigned NOT NULL,
+  `name` varchar(256) NOT NULL DEFAULT '',
+  `source` varchar(16) NOT NULL DEFAULT '',
+  `subject` varchar(2048) NOT NULL DEFAULT '',
+  `operator` varchar(16) NOT NULL DEFAULT '',
+  `value` varchar(2048) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `custom_issue_rules_project` (`project_id`),
+  UNIQUE KEY `custom_issue_rules_issue_type` (`issue_type_id`),
+  CONSTRAINT `custom_issue_rules_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `custom_issue_rules_issue_type` FOREIGN KEY (`issue_type_id`) REFERENCES `issue_types` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `issue_thresholds` (
+  `project_id` int unsigned NOT NULL,
+  `min_words` int NOT NULL,
+  `max_dom_size` int NOT NULL,
+  `min_title_length` int NOT NULL,
+  `max_title_length` int NOT NULL,
+  `min_description_length` int NOT NULL,
+  `max_description_length` int NOT NULL,
+  `max_ttfb` int NOT NULL,
+  `max_image_size` bigint NOT NULL,
+  `max_alt_text_length` int NOT NULL,
+  `max_links` int NOT NULL,
+  PRIMARY KEY (`project_id`),
+  CONSTRAINT `issue_thresholds_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
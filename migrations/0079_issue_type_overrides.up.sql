@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `issue_type_overrides` (
+  `project_id` int unsigned NOT NULL,
+  `issue_type_id` int unsigned NOT NULL,
+  `priority` int NOT NULL,
+  `disabled` tinyint NOT NULL DEFAULT '0',
+  PRIMARY KEY (`project_id`, `issue_type_id`),
+  KEY `issue_type_overrides_issue_type` (`issue_type_id`),
+  CONSTRAINT `issue_type_overrides_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_type_overrides_issue_type` FOREIGN KEY (`issue_type_id`) REFERENCES `issue_types` (`id`) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `issue_suppressions` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `issue_type_id` int unsigned NOT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `url_hash` varchar(256) NOT NULL DEFAULT '',
+  `pattern` varchar(2048) NOT NULL DEFAULT '',
+  `like_pattern` varchar(4096) NOT NULL DEFAULT '',
+  `comment` text,
+  `user_id` int unsigned DEFAULT NULL,
+  `created` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `issue_suppressions_project_type` (`project_id`, `issue_type_id`),
+  KEY `issue_suppressions_url_hash` (`url_hash`),
+  KEY `issue_suppressions_issue_type` (`issue_type_id`),
+  KEY `issue_suppressions_user` (`user_id`),
+  CONSTRAINT `issue_suppressions_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_suppressions_issue_type` FOREIGN KEY (`issue_type_id`) REFERENCES `issue_types` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_suppressions_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE SET NULL
+);
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS `issue_workflows` (
+  `project_id` int unsigned NOT NULL,
+  `issue_type_id` int unsigned NOT NULL,
+  `status` varchar(32) NOT NULL DEFAULT 'open',
+  `assignee_id` int unsigned DEFAULT NULL,
+  `due_date` date DEFAULT NULL,
+  `updated` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`project_id`, `issue_type_id`),
+  KEY `issue_workflows_issue_type` (`issue_type_id`),
+  KEY `issue_workflows_assignee` (`assignee_id`),
+  CONSTRAINT `issue_workflows_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_workflows_issue_type` FOREIGN KEY (`issue_type_id`) REFERENCES `issue_types` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_workflows_assignee` FOREIGN KEY (`assignee_id`) REFERENCES `users` (`id`) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS `issue_comments` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `issue_type_id` int unsigned NOT NULL,
+  `user_id` int unsigned DEFAULT NULL,
+  `comment` text NOT NULL,
+  `created` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `issue_comments_project_type` (`project_id`, `issue_type_id`),
+  KEY `issue_comments_issue_type` (`issue_type_id`),
+  KEY `issue_comments_user` (`user_id`),
+  CONSTRAINT `issue_comments_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_comments_issue_type` FOREIGN KEY (`issue_type_id`) REFERENCES `issue_types` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `issue_comments_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE SET NULL
+);
@@ -0,0 +1,4 @@
+ALTER TABLE `pagereports` DROP COLUMN `simhash`;
+ALTER TABLE `issue_thresholds` DROP COLUMN `min_similarity`;
+
+DELETE FROM issue_types WHERE id = 86;
@@ -0,0 +1,4 @@
+ALTER TABLE `pagereports` ADD COLUMN `simhash` bigint unsigned NOT NULL DEFAULT 0;
+ALTER TABLE `issue_thresholds` ADD COLUMN `min_similarity` int NOT NULL DEFAULT 90;
+
+INSERT INTO issue_types (id, type, priority) VALUES(86, "ERROR_NEAR_DUPLICATE_CONTENT", 2);
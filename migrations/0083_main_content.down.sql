@@ -0,0 +1,4 @@
+DROP INDEX idx_main_text_hash ON pagereports;
+ALTER TABLE `pagereports` DROP COLUMN `main_text_excerpt`;
+ALTER TABLE `pagereports` DROP COLUMN `main_text_hash`;
+ALTER TABLE `pagereports` DROP COLUMN `main_words`;
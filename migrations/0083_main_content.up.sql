@@ -0,0 +1,4 @@
+ALTER TABLE `pagereports` ADD COLUMN `main_words` int NOT NULL DEFAULT 0;
+ALTER TABLE `pagereports` ADD COLUMN `main_text_hash` varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE `pagereports` ADD COLUMN `main_text_excerpt` varchar(512) NOT NULL DEFAULT '';
+CREATE INDEX idx_main_text_hash ON pagereports (main_text_hash);
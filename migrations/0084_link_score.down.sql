@@ -0,0 +1 @@
+ALTER TABLE `pagereports` DROP COLUMN `link_score`;
@@ -0,0 +1 @@
+ALTER TABLE `pagereports` ADD COLUMN `link_score` double NOT NULL DEFAULT 0;
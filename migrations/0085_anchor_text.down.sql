@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS `phrases`;
+
+DELETE FROM issue_types WHERE id = 87;
+DELETE FROM issue_types WHERE id = 88;
+DELETE FROM issue_types WHERE id = 89;
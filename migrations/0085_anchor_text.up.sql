@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `phrases` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `kind` varchar(20) NOT NULL,
+  `lang` varchar(10) NOT NULL,
+  `text` varchar(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `phrases_project_kind_lang_text` (`project_id`, `kind`, `lang`, `text`),
+  CONSTRAINT `phrases_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
+
+INSERT INTO issue_types (id, type, priority) VALUES(87, "ERROR_EMPTY_ANCHOR_TEXT", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(88, "ERROR_GENERIC_ANCHOR_TEXT", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(89, "ERROR_IMAGE_LINK_WITHOUT_ALT", 2);
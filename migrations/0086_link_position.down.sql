@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `link_position_rules`;
+
+ALTER TABLE `links` DROP COLUMN `position`;
+ALTER TABLE `external_links` DROP COLUMN `position`;
@@ -0,0 +1,11 @@
+ALTER TABLE `links` ADD COLUMN `position` varchar(20) NOT NULL DEFAULT 'content';
+ALTER TABLE `external_links` ADD COLUMN `position` varchar(20) NOT NULL DEFAULT 'content';
+
+CREATE TABLE IF NOT EXISTS `link_position_rules` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `project_id` int unsigned NOT NULL,
+  `xpath` varchar(2048) NOT NULL,
+  `position` varchar(20) NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `link_position_rules_project` FOREIGN KEY (`project_id`) REFERENCES `projects` (`id`) ON DELETE CASCADE
+);
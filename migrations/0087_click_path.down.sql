@@ -0,0 +1,2 @@
+ALTER TABLE `pagereports` DROP COLUMN `click_depth`;
+ALTER TABLE `pagereports` DROP COLUMN `click_parent_id`;
@@ -0,0 +1,2 @@
+ALTER TABLE `pagereports` ADD COLUMN `click_depth` int NOT NULL DEFAULT -1;
+ALTER TABLE `pagereports` ADD COLUMN `click_parent_id` int unsigned NOT NULL DEFAULT 0;
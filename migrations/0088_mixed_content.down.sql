@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `mixed_content`;
+
+DELETE FROM issue_types WHERE id = 90;
+DELETE FROM issue_types WHERE id = 91;
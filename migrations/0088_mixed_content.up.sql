@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `mixed_content` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `type` varchar(10) NOT NULL,
+  `element` varchar(20) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `mixed_content_pagereport` (`pagereport_id`),
+  KEY `mixed_content_crawl` (`crawl_id`),
+  CONSTRAINT `mixed_content_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `mixed_content_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+INSERT INTO issue_types (id, type, priority) VALUES(90, "ERROR_ACTIVE_MIXED_CONTENT", 1);
+INSERT INTO issue_types (id, type, priority) VALUES(91, "ERROR_PASSIVE_MIXED_CONTENT", 3);
@@ -0,0 +1,10 @@
+DELETE FROM issue_types WHERE id = 92;
+DELETE FROM issue_types WHERE id = 93;
+DELETE FROM issue_types WHERE id = 94;
+DELETE FROM issue_types WHERE id = 95;
+DELETE FROM issue_types WHERE id = 96;
+DELETE FROM issue_types WHERE id = 97;
+DELETE FROM issue_types WHERE id = 98;
+DELETE FROM issue_types WHERE id = 99;
+
+ALTER TABLE `issue_types` DROP COLUMN `category`;
@@ -0,0 +1,10 @@
+ALTER TABLE `issue_types` ADD COLUMN `category` varchar(50) NOT NULL DEFAULT '';
+
+INSERT INTO issue_types (id, type, priority, category) VALUES(92, "ERROR_INPUT_WITHOUT_LABEL", 2, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(93, "ERROR_BUTTON_WITHOUT_NAME", 2, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(94, "ERROR_LINK_WITHOUT_NAME", 2, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(95, "ERROR_INVALID_ARIA_LABELLED_BY", 3, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(96, "ERROR_POSITIVE_TABINDEX", 3, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(97, "ERROR_IFRAME_WITHOUT_TITLE", 3, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(98, "ERROR_TABLE_WITHOUT_HEADERS", 3, "accessibility");
+INSERT INTO issue_types (id, type, priority, category) VALUES(99, "ERROR_USER_SCALABLE_DISABLED", 2, "accessibility");
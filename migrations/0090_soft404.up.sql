@@ -0,0 +1 @@
+INSERT INTO issue_types (id, type, priority) VALUES(100, "ERROR_SOFT_404", 2);
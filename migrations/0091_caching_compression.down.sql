@@ -0,0 +1,6 @@
+ALTER TABLE `pagereports` DROP COLUMN `transfer_size`;
+
+DELETE FROM issue_types WHERE id = 101;
+DELETE FROM issue_types WHERE id = 102;
+DELETE FROM issue_types WHERE id = 103;
+DELETE FROM issue_types WHERE id = 104;
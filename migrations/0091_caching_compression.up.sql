@@ -0,0 +1,6 @@
+ALTER TABLE `pagereports` ADD COLUMN `transfer_size` bigint NOT NULL DEFAULT 0;
+
+INSERT INTO issue_types (id, type, priority) VALUES(101, "ERROR_STATIC_CACHE_CONTROL", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(102, "ERROR_HTML_NO_STORE", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(103, "ERROR_MISSING_VALIDATORS", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(104, "ERROR_UNCOMPRESSED_TEXT", 2);
@@ -0,0 +1,11 @@
+UPDATE issue_types SET category = "" WHERE id IN (50, 51, 52);
+
+DELETE FROM issue_types WHERE id = 105;
+DELETE FROM issue_types WHERE id = 106;
+DELETE FROM issue_types WHERE id = 107;
+DELETE FROM issue_types WHERE id = 108;
+DELETE FROM issue_types WHERE id = 109;
+DELETE FROM issue_types WHERE id = 110;
+DELETE FROM issue_types WHERE id = 111;
+DELETE FROM issue_types WHERE id = 112;
+DELETE FROM issue_types WHERE id = 113;
@@ -0,0 +1,11 @@
+UPDATE issue_types SET category = "security" WHERE id IN (50, 51, 52);
+
+INSERT INTO issue_types (id, type, priority, category) VALUES(105, "ERROR_COOKIE_WITHOUT_SECURE", 2, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(106, "ERROR_COOKIE_WITHOUT_HTTPONLY", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(107, "ERROR_COOKIE_WITHOUT_SAMESITE", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(108, "ERROR_INVALID_CSP", 2, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(109, "ERROR_UNSAFE_CSP", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(110, "ERROR_REFERRER_POLICY", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(111, "ERROR_MISSING_PERMISSIONS_POLICY", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(112, "ERROR_MISSING_FRAMING_PROTECTION", 3, "security");
+INSERT INTO issue_types (id, type, priority, category) VALUES(113, "ERROR_WEAK_HSTS", 3, "security");
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS `page_weights`;
+DROP TABLE IF EXISTS `fonts`;
+
+ALTER TABLE `issue_thresholds` DROP COLUMN `max_page_weight`;
+ALTER TABLE `issue_thresholds` DROP COLUMN `max_scripts_weight`;
+ALTER TABLE `issue_thresholds` DROP COLUMN `max_page_requests`;
+
+DELETE FROM issue_types WHERE id = 114;
+DELETE FROM issue_types WHERE id = 115;
+DELETE FROM issue_types WHERE id = 116;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS `fonts` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `fonts_pagereport` (`pagereport_id`),
+  KEY `fonts_crawl` (`crawl_id`),
+  CONSTRAINT `fonts_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `fonts_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS `page_weights` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `document` bigint NOT NULL DEFAULT 0,
+  `scripts` bigint NOT NULL DEFAULT 0,
+  `styles` bigint NOT NULL DEFAULT 0,
+  `images` bigint NOT NULL DEFAULT 0,
+  `fonts` bigint NOT NULL DEFAULT 0,
+  `total` bigint NOT NULL DEFAULT 0,
+  `scripts_count` int NOT NULL DEFAULT 0,
+  `styles_count` int NOT NULL DEFAULT 0,
+  `images_count` int NOT NULL DEFAULT 0,
+  `fonts_count` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `page_weights_pagereport` (`pagereport_id`),
+  KEY `page_weights_crawl_total` (`crawl_id`, `total`),
+  CONSTRAINT `page_weights_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `page_weights_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+ALTER TABLE `issue_thresholds` ADD COLUMN `max_page_weight` bigint NOT NULL DEFAULT 3145728;
+ALTER TABLE `issue_thresholds` ADD COLUMN `max_scripts_weight` bigint NOT NULL DEFAULT 1048576;
+ALTER TABLE `issue_thresholds` ADD COLUMN `max_page_requests` int NOT NULL DEFAULT 100;
+
+INSERT INTO issue_types (id, type, priority) VALUES(114, "ERROR_PAGE_WEIGHT_BUDGET", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(115, "ERROR_SCRIPTS_WEIGHT_BUDGET", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(116, "ERROR_PAGE_REQUESTS_BUDGET", 3);
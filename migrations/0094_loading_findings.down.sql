@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS `loading_findings`;
+
+DELETE FROM issue_types WHERE id = 117;
+DELETE FROM issue_types WHERE id = 118;
+DELETE FROM issue_types WHERE id = 119;
+DELETE FROM issue_types WHERE id = 120;
+DELETE FROM issue_types WHERE id = 121;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS `loading_findings` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `type` varchar(20) NOT NULL,
+  `element` varchar(20) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `loading_findings_pagereport` (`pagereport_id`),
+  KEY `loading_findings_crawl` (`crawl_id`),
+  CONSTRAINT `loading_findings_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `loading_findings_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
+
+INSERT INTO issue_types (id, type, priority) VALUES(117, "ERROR_RENDER_BLOCKING_SCRIPT", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(118, "ERROR_TOO_MANY_HEAD_STYLESHEETS", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(119, "ERROR_UNUSED_PRELOAD", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(120, "ERROR_LAZY_LCP_IMAGE", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(121, "ERROR_IMAGES_WITHOUT_LAZY_LOADING", 3);
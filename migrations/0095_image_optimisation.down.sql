@@ -0,0 +1,11 @@
+ALTER TABLE `pagereports` DROP COLUMN `image_width`;
+ALTER TABLE `pagereports` DROP COLUMN `image_height`;
+ALTER TABLE `images` DROP COLUMN `width`;
+ALTER TABLE `images` DROP COLUMN `height`;
+ALTER TABLE `images` DROP COLUMN `srcset`;
+ALTER TABLE `issue_thresholds` DROP COLUMN `max_legacy_image_size`;
+
+DELETE FROM issue_types WHERE id = 122;
+DELETE FROM issue_types WHERE id = 123;
+DELETE FROM issue_types WHERE id = 124;
+DELETE FROM issue_types WHERE id = 125;
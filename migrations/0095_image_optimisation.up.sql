@@ -0,0 +1,11 @@
+ALTER TABLE `pagereports` ADD COLUMN `image_width` int NOT NULL DEFAULT 0;
+ALTER TABLE `pagereports` ADD COLUMN `image_height` int NOT NULL DEFAULT 0;
+ALTER TABLE `images` ADD COLUMN `width` int NOT NULL DEFAULT 0;
+ALTER TABLE `images` ADD COLUMN `height` int NOT NULL DEFAULT 0;
+ALTER TABLE `images` ADD COLUMN `srcset` tinyint NOT NULL DEFAULT 0;
+ALTER TABLE `issue_thresholds` ADD COLUMN `max_legacy_image_size` bigint NOT NULL DEFAULT 102400;
+
+INSERT INTO issue_types (id, type, priority) VALUES(122, "ERROR_OVERSIZED_IMAGE", 2);
+INSERT INTO issue_types (id, type, priority) VALUES(123, "ERROR_DISTORTED_IMAGE", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(124, "ERROR_LEGACY_IMAGE_FORMAT", 3);
+INSERT INTO issue_types (id, type, priority) VALUES(125, "ERROR_BROKEN_SRCSET", 2);
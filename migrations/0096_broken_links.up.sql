@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `broken_links` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `url` varchar(2048) NOT NULL DEFAULT '',
+  `text` varchar(1024) NOT NULL DEFAULT '',
+  `position` varchar(20) NOT NULL DEFAULT '',
+  `type` varchar(20) NOT NULL DEFAULT '',
+  `status_code` int NOT NULL DEFAULT 0,
+  `sources` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  KEY `broken_links_pagereport` (`pagereport_id`),
+  KEY `broken_links_crawl_sources` (`crawl_id`, `sources`),
+  CONSTRAINT `broken_links_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `broken_links_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);
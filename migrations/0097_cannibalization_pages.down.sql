@@ -0,0 +1 @@
+DROP TABLE IF EXISTS `cannibalization_pages`;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `cannibalization_pages` (
+  `id` int unsigned NOT NULL AUTO_INCREMENT,
+  `pagereport_id` int unsigned NOT NULL,
+  `crawl_id` int unsigned DEFAULT NULL,
+  `cluster` int NOT NULL DEFAULT 0,
+  `position` int NOT NULL DEFAULT 0,
+  `terms` varchar(1024) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`),
+  KEY `cannibalization_pages_pagereport` (`pagereport_id`),
+  KEY `cannibalization_pages_crawl_cluster` (`crawl_id`, `cluster`, `position`),
+  CONSTRAINT `cannibalization_pages_crawl` FOREIGN KEY (`crawl_id`) REFERENCES `crawls` (`id`) ON DELETE CASCADE,
+  CONSTRAINT `cannibalization_pages_pagereport` FOREIGN KEY (`pagereport_id`) REFERENCES `pagereports` (`id`) ON DELETE CASCADE
+);